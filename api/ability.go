@@ -2,15 +2,13 @@ package kanka
 
 import (
 	"context"
-	"fmt"
+	"net/url"
 	"time"
 )
 
 // Abilities is used to query the abilities endpoints
 type Abilities struct {
-	client     *Client
-	campaignID int
-	urlPrefix  string
+	*EntityService[Ability, AbilityRequest]
 }
 
 // Ability is used to serialize an ability object
@@ -36,43 +34,151 @@ type Ability struct {
 	UpdatedBy int       `json:"updated_by"`
 }
 
+// AbilityRequest is used to create or update an ability
+type AbilityRequest struct {
+	Name      string  `json:"name"`
+	Entry     *string `json:"entry,omitempty"`
+	AbilityID *int    `json:"ability_id,omitempty"`
+	Charges   *int    `json:"charges,omitempty"`
+	Type      *string `json:"type,omitempty"`
+
+	IsPrivate *bool `json:"is_private,omitempty"`
+}
+
+// AbilityFilter is used to filter abilities in ListOptions
+type AbilityFilter struct {
+	AbilityID int
+}
+
+// Apply implements Filter
+func (f AbilityFilter) Apply(q url.Values) {
+	setInt(q, "ability_id", f.AbilityID)
+}
+
 func init() {
 	addKnownLinkType("ability", func(ctx context.Context, client *Client, campaignID int, entityID int) string {
 		ability, _ := client.Abilities(campaignID).GetAbility(ctx, entityID)
 		return ability.Name
 	})
+	addKnownSyncType("ability", func(ctx context.Context, client *Client, campaignID int, opts *ListOptions) ([]SyncRecord, error) {
+		records := []SyncRecord{}
+		err := client.Abilities(campaignID).Iterate(ctx, opts, func(ability *Ability) error {
+			records = append(records, newSyncRecord(ability))
+			return nil
+		})
+		return records, err
+	})
+	addKnownEntityType("ability", func(ctx context.Context, client *Client, campaignID int, id int) (Entity, error) {
+		return client.Abilities(campaignID).GetAbility(ctx, id)
+	})
 }
 
 // Abilities returns a handle of the abilities endpoint
 func (c *Client) Abilities(campaignID int) *Abilities {
 	return &Abilities{
-		client:     c,
-		campaignID: campaignID,
-		urlPrefix:  fmt.Sprintf("/campaigns/%d/abilities", campaignID),
+		EntityService: newEntityService[Ability, AbilityRequest](c, campaignID, "abilities"),
 	}
 }
 
 // GetAbilities can return information about all abilities
 func (a *Abilities) GetAbilities(ctx context.Context) (*[]Ability, error) {
+	return a.GetAll(ctx)
+}
 
-	var err error
-	resp := []Ability{}
-	url := a.urlPrefix
+// GetAbility can return information about a single ability
+func (a *Abilities) GetAbility(ctx context.Context, id int) (*Ability, error) {
+	return a.Get(ctx, id)
+}
 
-	for len(url) > 0 && err == nil {
-		page := []Ability{}
-		url, err = a.client.makeRequest(ctx, "GET", url, &page)
-		resp = append(resp, page...)
-	}
+// GetAbilityWithPosts can return information about a single ability along with all of its posts
+func (a *Abilities) GetAbilityWithPosts(ctx context.Context, id int) (*Ability, *[]EntityPost, error) {
+	return getWithPosts(ctx, a.EntityService, id)
+}
 
-	return &resp, err
+// CreateAbility can create a new ability and return the result
+func (a *Abilities) CreateAbility(ctx context.Context, ability *AbilityRequest) (*Ability, error) {
+	return a.Create(ctx, ability)
 }
 
-// GetAbility can return information about a single ability
-func (a *Abilities) GetAbility(ctx context.Context, id int) (*Ability, error) {
+// UpdateAbility can update an ability and return the result
+func (a *Abilities) UpdateAbility(ctx context.Context, id int, ability *AbilityRequest) (*Ability, error) {
+	return a.Update(ctx, id, ability)
+}
+
+// PatchAbility can update only the given fields of an ability and return the result
+func (a *Abilities) PatchAbility(ctx context.Context, id int, fields map[string]interface{}) (*Ability, error) {
+	return a.Patch(ctx, id, fields)
+}
+
+// DeleteAbility can delete an ability
+func (a *Abilities) DeleteAbility(ctx context.Context, id int) error {
+	return a.Delete(ctx, id)
+}
+
+// GetID implements Entity
+func (a *Ability) GetID() int {
+	return a.ID
+}
+
+// GetEntityID implements Entity
+func (a *Ability) GetEntityID() int {
+	return a.EntityID
+}
+
+// GetName implements Entity
+func (a *Ability) GetName() string {
+	return a.Name
+}
+
+// EntityType implements Entity
+func (a *Ability) EntityType() string {
+	return "ability"
+}
+
+// GetEntry implements Entity
+func (a *Ability) GetEntry() string {
+	return a.Entry
+}
 
-	resp := Ability{}
-	_, err := a.client.makeRequest(ctx, "GET", fmt.Sprintf("%s/%d", a.urlPrefix, id), &resp)
+// GetType implements Entity
+func (a *Ability) GetType() string {
+	return a.Type
+}
+
+// GetIsPrivate implements Entity
+func (a *Ability) GetIsPrivate() bool {
+	return a.IsPrivate
+}
 
-	return &resp, err
+// GetImage implements Entity
+func (a *Ability) GetImage() string {
+	return a.Image
 }
+
+// GetImageThumb implements Entity
+func (a *Ability) GetImageThumb() string {
+	return a.ImageThumb
+}
+
+// GetCreatedAt implements Entity
+func (a *Ability) GetCreatedAt() time.Time {
+	return a.CreatedAt
+}
+
+// GetCreatedBy implements Entity
+func (a *Ability) GetCreatedBy() int {
+	return a.CreatedBy
+}
+
+// GetUpdatedAt implements Entity
+func (a *Ability) GetUpdatedAt() time.Time {
+	return a.UpdatedAt
+}
+
+// GetUpdatedBy implements Entity
+func (a *Ability) GetUpdatedBy() int {
+	return a.UpdatedBy
+}
+
+// AbilityPager is used to iterate over pages of abilities
+type AbilityPager = Pager[Ability]
@@ -0,0 +1,180 @@
+package kanka
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Attribute types, as found in Attribute.Type
+const (
+	AttributeTypeStandard = ""
+	AttributeTypeText     = "text"
+	AttributeTypeCheckbox = "checkbox"
+	AttributeTypeSection  = "section"
+	AttributeTypeNumber   = "number"
+	AttributeTypeRandom   = "random"
+)
+
+// attributeReferenceMax is how deeply references to attributes which themselves have references are resolved
+const attributeReferenceMax = 16
+
+var attributeReferenceRe *regexp.Regexp = regexp.MustCompile(`\{([^{}]+)\}`)
+
+// Attributes is used to query the attributes endpoints of an entity
+type Attributes struct {
+	*EntityService[Attribute, AttributeRequest]
+}
+
+// Attribute is used to serialize an entity attribute object
+type Attribute struct {
+	ID        int  `json:"id"`
+	EntityID  int  `json:"entity_id"`
+	IsPrivate bool `json:"is_private"`
+	IsStar    bool `json:"is_star"`
+
+	Name         string `json:"name"`
+	Value        string `json:"value"`
+	Type         string `json:"type"`
+	DefaultOrder int    `json:"default_order"`
+	APIKey       string `json:"api_key"`
+
+	CreatedAt time.Time `json:"created_at"`
+	CreatedBy int       `json:"created_by"`
+	UpdatedAt time.Time `json:"updated_at"`
+	UpdatedBy int       `json:"updated_by"`
+}
+
+// AttributeRequest is used to create or update an attribute
+type AttributeRequest struct {
+	Name         string  `json:"name"`
+	Value        *string `json:"value,omitempty"`
+	Type         *string `json:"type,omitempty"`
+	DefaultOrder *int    `json:"default_order,omitempty"`
+
+	IsPrivate *bool `json:"is_private,omitempty"`
+	IsStar    *bool `json:"is_star,omitempty"`
+}
+
+// Attributes returns a handle on the attributes endpoints of an entity
+func (c *Client) Attributes(campaignID int, entityID int) *Attributes {
+	return &Attributes{
+		EntityService: newEntityService[Attribute, AttributeRequest](c, campaignID, fmt.Sprintf("entities/%d/attributes", entityID)),
+	}
+}
+
+// GetAttributes can return information about all attributes of the entity
+func (a *Attributes) GetAttributes(ctx context.Context) (*[]Attribute, error) {
+	return a.GetAll(ctx)
+}
+
+// GetResolvedAttributes returns all attributes of the entity with references to other attributes resolved
+func (a *Attributes) GetResolvedAttributes(ctx context.Context) (*[]Attribute, error) {
+
+	attributes, err := a.GetAll(ctx)
+	if err != nil {
+		return attributes, err
+	}
+
+	resolved := ResolveAttributes(*attributes)
+	return &resolved, nil
+}
+
+// GetAttribute can return information about a single attribute
+func (a *Attributes) GetAttribute(ctx context.Context, id int) (*Attribute, error) {
+	return a.Get(ctx, id)
+}
+
+// CreateAttribute can create a new attribute and return the result
+func (a *Attributes) CreateAttribute(ctx context.Context, attribute *AttributeRequest) (*Attribute, error) {
+	return a.Create(ctx, attribute)
+}
+
+// UpdateAttribute can update an attribute and return the result
+func (a *Attributes) UpdateAttribute(ctx context.Context, id int, attribute *AttributeRequest) (*Attribute, error) {
+	return a.Update(ctx, id, attribute)
+}
+
+// PatchAttribute can update only the given fields of an attribute and return the result
+func (a *Attributes) PatchAttribute(ctx context.Context, id int, fields map[string]interface{}) (*Attribute, error) {
+	return a.Patch(ctx, id, fields)
+}
+
+// DeleteAttribute can delete an attribute
+func (a *Attributes) DeleteAttribute(ctx context.Context, id int) error {
+	return a.Delete(ctx, id)
+}
+
+// AsText returns the attribute's value as text
+func (a *Attribute) AsText() string {
+	return a.Value
+}
+
+// AsInt returns the attribute's value as an integer, e.g. for number attributes
+func (a *Attribute) AsInt() (int, error) {
+	return strconv.Atoi(strings.TrimSpace(a.Value))
+}
+
+// AsBool returns the attribute's value as a boolean, e.g. for checkbox attributes.
+// Empty values are false.
+func (a *Attribute) AsBool() (bool, error) {
+
+	value := strings.ToLower(strings.TrimSpace(a.Value))
+	switch value {
+	case "", "off", "no":
+		return false, nil
+	case "on", "yes":
+		return true, nil
+	}
+
+	return strconv.ParseBool(value)
+}
+
+// ResolveAttributes returns a copy of attributes where references to other attributes by name, e.g. {str},
+// are replaced with their values. Names are matched case-insensitively. References to unknown attributes,
+// and references which refer back to themselves, are left as they are.
+func ResolveAttributes(attributes []Attribute) []Attribute {
+
+	values := make(map[string]string, len(attributes))
+	for _, attribute := range attributes {
+		key := strings.ToLower(strings.TrimSpace(attribute.Name))
+		if _, ok := values[key]; !ok {
+			values[key] = attribute.Value
+		}
+	}
+
+	resolved := make([]Attribute, len(attributes))
+	for i, attribute := range attributes {
+		resolved[i] = attribute
+		resolved[i].Value = resolveAttributeValue(attribute.Value, values, map[string]bool{
+			strings.ToLower(strings.TrimSpace(attribute.Name)): true,
+		}, 0)
+	}
+
+	return resolved
+}
+
+// resolveAttributeValue replaces the references in value, skipping the attributes currently being resolved
+func resolveAttributeValue(value string, values map[string]string, resolving map[string]bool, depth int) string {
+
+	if depth >= attributeReferenceMax || !strings.Contains(value, "{") {
+		return value
+	}
+
+	return attributeReferenceRe.ReplaceAllStringFunc(value, func(reference string) string {
+
+		key := strings.ToLower(strings.TrimSpace(reference[1 : len(reference)-1]))
+		referenced, ok := values[key]
+		if !ok || resolving[key] {
+			return reference
+		}
+
+		resolving[key] = true
+		defer delete(resolving, key)
+
+		return resolveAttributeValue(referenced, values, resolving, depth+1)
+	})
+}
@@ -0,0 +1,147 @@
+package kanka
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAttributes(t *testing.T) {
+
+	client := NewClient(DefaultConfig())
+	a := client.Attributes(1, 4)
+
+	assert.Equal(t, client, a.client)
+	assert.Equal(t, "/campaigns/1/entities/4/attributes", a.urlPrefix)
+}
+
+func TestGetAttributes(t *testing.T) {
+
+	testServer, config := mockTestServer()
+	defer testServer.Close()
+	client := NewClient(config)
+	ctx := context.Background()
+
+	attributes, err := client.Attributes(1, 4).GetAttributes(ctx)
+
+	if assert.NoError(t, err) {
+		assert.Len(t, *attributes, 4)
+
+		a := (*attributes)[0]
+		assert.Equal(t, 1, a.ID)
+		assert.Equal(t, 4, a.EntityID)
+		assert.Equal(t, "Str", a.Name)
+		assert.Equal(t, "14", a.Value)
+		assert.Equal(t, AttributeTypeStandard, a.Type)
+		assert.Equal(t, true, a.IsStar)
+		assert.Equal(t, time.Date(2019, time.January, 29, 16, 40, 34, 0, time.UTC), a.CreatedAt)
+
+		assert.Equal(t, AttributeTypeCheckbox, (*attributes)[2].Type)
+		assert.Equal(t, true, (*attributes)[3].IsPrivate)
+	}
+}
+
+func TestGetAttribute(t *testing.T) {
+
+	testServer, config := mockTestServer()
+	defer testServer.Close()
+	client := NewClient(config)
+	ctx := context.Background()
+
+	attribute, err := client.Attributes(1, 4).GetAttribute(ctx, 1)
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, 1, attribute.ID)
+		assert.Equal(t, "Str", attribute.Name)
+	}
+}
+
+func TestWriteAttributes(t *testing.T) {
+
+	testServer, config, requests := recordingTestServer()
+	defer testServer.Close()
+	client := NewClient(config)
+	ctx := context.Background()
+	attributes := client.Attributes(1, 4)
+
+	_, err := attributes.CreateAttribute(ctx, &AttributeRequest{Name: "Dex", Value: String("12"), Type: String(AttributeTypeNumber)})
+	assert.NoError(t, err)
+	assert.Equal(t, recordedRequest{Method: "POST", Path: "/campaigns/1/entities/4/attributes", Body: `{"name":"Dex","value":"12","type":"number"}`}, requests.last())
+
+	_, err = attributes.UpdateAttribute(ctx, 1, &AttributeRequest{Name: "Str", Value: String(""), IsStar: Bool(false)})
+	assert.NoError(t, err)
+	assert.Equal(t, recordedRequest{Method: "PUT", Path: "/campaigns/1/entities/4/attributes/1", Body: `{"name":"Str","value":"","is_star":false}`}, requests.last())
+
+	_, err = attributes.PatchAttribute(ctx, 1, map[string]interface{}{"value": "16"})
+	assert.NoError(t, err)
+	assert.Equal(t, recordedRequest{Method: "PATCH", Path: "/campaigns/1/entities/4/attributes/1", Body: `{"value":"16"}`}, requests.last())
+
+	assert.NoError(t, attributes.DeleteAttribute(ctx, 1))
+	assert.Equal(t, recordedRequest{Method: "DELETE", Path: "/campaigns/1/entities/4/attributes/1"}, requests.last())
+}
+
+func TestAttributeValues(t *testing.T) {
+
+	a := &Attribute{Value: " 14 "}
+	assert.Equal(t, " 14 ", a.AsText())
+
+	value, err := a.AsInt()
+	assert.NoError(t, err)
+	assert.Equal(t, 14, value)
+
+	a.Value = "fourteen"
+	_, err = a.AsInt()
+	assert.Error(t, err)
+
+	for raw, expected := range map[string]bool{"": false, "0": false, "off": false, "1": true, "true": true, "On": true} {
+		a.Value = raw
+		value, err := a.AsBool()
+		assert.NoError(t, err)
+		assert.Equal(t, expected, value, raw)
+	}
+
+	a.Value = "maybe"
+	_, err = a.AsBool()
+	assert.Error(t, err)
+}
+
+func TestResolveAttributes(t *testing.T) {
+
+	attributes := []Attribute{
+		{Name: "Str", Value: "14"},
+		{Name: "Attack", Value: "{str} + {Level}"},
+		{Name: "Level", Value: "3"},
+		{Name: "Damage", Value: "{attack}"},
+		{Name: "Loop", Value: "{loop}"},
+		{Name: "Ping", Value: "{pong}"},
+		{Name: "Pong", Value: "{ping}"},
+		{Name: "Unknown", Value: "{missing}"},
+	}
+
+	resolved := ResolveAttributes(attributes)
+
+	values := []string{}
+	for _, attribute := range resolved {
+		values = append(values, attribute.Value)
+	}
+	assert.Equal(t, []string{"14", "14 + 3", "3", "14 + 3", "{loop}", "{ping}", "{pong}", "{missing}"}, values)
+
+	// The original attributes are left alone
+	assert.Equal(t, "{str} + {Level}", attributes[1].Value)
+}
+
+func TestGetResolvedAttributes(t *testing.T) {
+
+	testServer, config := mockTestServer()
+	defer testServer.Close()
+	client := NewClient(config)
+	ctx := context.Background()
+
+	attributes, err := client.Attributes(1, 4).GetResolvedAttributes(ctx)
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, "14", (*attributes)[1].Value)
+	}
+}
@@ -0,0 +1,158 @@
+package kanka
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// DefaultBatchWorkers is the number of concurrent requests made by batch getters such as Characters.GetMany
+const DefaultBatchWorkers = 4
+
+// BatchOptions is used to configure batch getters such as Characters.GetMany
+type BatchOptions struct {
+	// Workers is the number of requests made concurrently. Defaults to DefaultBatchWorkers.
+	// All requests still share the client's rate-limiter.
+	Workers int
+}
+
+// fetchMany calls fetch with each index and ID in ids, using a bounded number of concurrent workers.
+// Returns the errors of failed fetches keyed by ID, or nil if every fetch succeeded.
+func fetchMany(ctx context.Context, ids []int, opts *BatchOptions, fetch func(ctx context.Context, i int, id int) error) map[int]error {
+
+	workers := DefaultBatchWorkers
+	if opts != nil && opts.Workers > 0 {
+		workers = opts.Workers
+	}
+	if workers > len(ids) {
+		workers = len(ids)
+	}
+
+	var mu sync.Mutex
+	var errs map[int]error
+	var wg sync.WaitGroup
+
+	indexes := make(chan int)
+
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+
+				// Don't start new requests once the batch has been cancelled
+				err := ctx.Err()
+				if err == nil {
+					err = fetch(ctx, i, ids[i])
+				}
+
+				if err != nil {
+					mu.Lock()
+					if errs == nil {
+						errs = make(map[int]error)
+					}
+					errs[ids[i]] = err
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+
+	for i := range ids {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	return errs
+}
+
+// lowestError returns the lowest ID in errs with its error, so that batches report the same error on every run
+func lowestError(errs map[int]error) (int, error) {
+
+	ids := make([]int, 0, len(errs))
+	for id := range errs {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	return ids[0], errs[ids[0]]
+}
+
+// fetchAll calls each fetch concurrently and waits for all of them to return.
+// Returns the error of the first failed fetch in the order they were given, or nil if every fetch succeeded.
+func fetchAll(ctx context.Context, fetches ...func(ctx context.Context) error) error {
+
+	errs := make([]error, len(fetches))
+	var wg sync.WaitGroup
+
+	for i, fetch := range fetches {
+		wg.Add(1)
+		go func(i int, fetch func(ctx context.Context) error) {
+			defer wg.Done()
+			errs[i] = fetch(ctx)
+		}(i, fetch)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// resolveMany fetches the objects with the given IDs, skipping zero and duplicate IDs, and returns them keyed by ID.
+// Objects which could be fetched are returned even if others failed, along with the error of the lowest failed ID,
+// e.g. "fetching item 404: ..." for the kind "item".
+func resolveMany[T any, R any](ctx context.Context, service *EntityService[T, R], kind string, ids []int) (map[int]*T, error) {
+
+	unique := []int{}
+	seen := make(map[int]bool)
+	for _, id := range ids {
+		if id != 0 && !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+
+	fetched, errs := service.GetMany(ctx, unique, nil)
+	resolved := make(map[int]*T, len(unique))
+	for i, id := range unique {
+		if fetched[i] != nil {
+			resolved[id] = fetched[i]
+		}
+	}
+
+	if id, err := lowestError(errs); err != nil {
+		return resolved, fmt.Errorf("fetching %s %d: %w", kind, id, err)
+	}
+
+	return resolved, nil
+}
+
+// joinMany joins each element with the object it refers to by ID, using resolveMany to fetch the objects.
+// The object passed to join is nil if the element refers to none or it couldn't be fetched, so every element
+// is joined even if resolveMany fails.
+func joinMany[E any, T any, R any, J any](ctx context.Context, service *EntityService[T, R], kind string, elements []E, id func(E) int, join func(E, *T) J) ([]J, error) {
+
+	ids := make([]int, 0, len(elements))
+	for _, element := range elements {
+		ids = append(ids, id(element))
+	}
+	objects, err := resolveMany(ctx, service, kind, ids)
+
+	joined := make([]J, 0, len(elements))
+	for _, element := range elements {
+		joined = append(joined, join(element, objects[id(element)]))
+	}
+
+	return joined, err
+}
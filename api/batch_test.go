@@ -0,0 +1,84 @@
+package kanka
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFetchMany(t *testing.T) {
+
+	ctx := context.Background()
+	ids := []int{5, 4, 3, 2, 1, 10, 20, 30}
+
+	var mu sync.Mutex
+	running, maxRunning := 0, 0
+	fetched := make([]int, len(ids))
+
+	errs := fetchMany(ctx, ids, &BatchOptions{Workers: 3}, func(ctx context.Context, i int, id int) error {
+
+		mu.Lock()
+		running++
+		if running > maxRunning {
+			maxRunning = running
+		}
+		mu.Unlock()
+
+		time.Sleep(10 * time.Millisecond)
+
+		mu.Lock()
+		running--
+		mu.Unlock()
+
+		if id >= 10 {
+			return errors.New("too big")
+		}
+		fetched[i] = id
+		return nil
+	})
+
+	// Results keep their order, errors are keyed by ID, and no more than 3 fetches ran at once
+	assert.Equal(t, []int{5, 4, 3, 2, 1, 0, 0, 0}, fetched)
+	assert.Len(t, errs, 3)
+	assert.EqualError(t, errs[20], "too big")
+	assert.Equal(t, 3, maxRunning)
+
+	// Successful batches have no errors
+	errs = fetchMany(ctx, ids, nil, func(ctx context.Context, i int, id int) error { return nil })
+	assert.Nil(t, errs)
+
+	// Empty batches do nothing
+	errs = fetchMany(ctx, []int{}, nil, func(ctx context.Context, i int, id int) error { return errors.New("called") })
+	assert.Nil(t, errs)
+
+	// Cancelled batches fail every ID without fetching
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+
+	errs = fetchMany(cancelled, ids, nil, func(ctx context.Context, i int, id int) error { return errors.New("called") })
+	assert.Len(t, errs, len(ids))
+	assert.True(t, errors.Is(errs[5], context.Canceled))
+}
+
+func TestFetchAll(t *testing.T) {
+
+	ctx := context.Background()
+	calls := make([]bool, 3)
+	first := assert.AnError
+	second := context.Canceled
+
+	err := fetchAll(ctx,
+		func(ctx context.Context) error { calls[0] = true; return nil },
+		func(ctx context.Context) error { calls[1] = true; return first },
+		func(ctx context.Context) error { calls[2] = true; return second },
+	)
+
+	// Every fetch runs, and the first error in order is returned
+	assert.Equal(t, []bool{true, true, true}, calls)
+	assert.Equal(t, first, err)
+	assert.NoError(t, fetchAll(ctx))
+}
@@ -0,0 +1,363 @@
+package kanka
+
+import (
+	"bytes"
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultCacheSize is the number of responses kept by an LRUCache created with a size of zero
+const DefaultCacheSize = 256
+
+// Cache stores the responses of GET requests so that they can be revalidated with conditional requests.
+// Caches are best-effort: an entry which can't be read or written behaves as a cache miss.
+type Cache interface {
+	// Get returns the entry stored under key, if there is one
+	Get(key string) (*CacheEntry, bool)
+	// Set stores an entry under key, replacing any existing entry
+	Set(key string, entry *CacheEntry)
+	// DeletePrefix removes every entry whose key starts with prefix
+	DeletePrefix(prefix string)
+}
+
+// CacheEntry is a cached response
+type CacheEntry struct {
+	ETag         string    `json:"etag,omitempty"`
+	LastModified string    `json:"last_modified,omitempty"`
+	Body         []byte    `json:"body"`
+	StoredAt     time.Time `json:"stored_at"`
+}
+
+// CacheStats counts how GET requests were served by a client's cache
+type CacheStats struct {
+	// Hits were served from the cache without making a request, because the entry was within CacheMaxAge
+	Hits int
+	// Revalidations were confirmed unchanged by Kanka (HTTP 304) and served from the cache
+	Revalidations int
+	// Misses were fetched in full
+	Misses int
+}
+
+// LRUCache is an in-memory Cache which evicts the least recently used entry once it is full
+type LRUCache struct {
+	mu      sync.Mutex
+	size    int
+	entries map[string]*list.Element
+	order   *list.List
+}
+
+// lruItem is an element of an LRUCache's order list
+type lruItem struct {
+	key   string
+	entry *CacheEntry
+}
+
+// DiskCache is a Cache which keeps each entry in its own file in a directory
+type DiskCache struct {
+	mu  sync.Mutex
+	dir string
+}
+
+// diskEntry is the file format of a DiskCache entry; the key is kept so that prefixes can be matched
+type diskEntry struct {
+	Key   string      `json:"key"`
+	Entry *CacheEntry `json:"entry"`
+}
+
+// CacheStats returns how the client's cache has served GET requests so far
+func (c *Client) CacheStats() CacheStats {
+
+	c.cacheMu.Lock()
+	defer c.cacheMu.Unlock()
+
+	return c.cacheStats
+}
+
+// cacheKey returns the cache key of an endpoint. Keys start with the URL so that writes can invalidate
+// a resource for every token, and end with a hash of the token so that tokens never share responses.
+func (c *Client) cacheKey(endpoint string) string {
+	token := sha256.Sum256([]byte(c.token))
+	return c.BaseURL + endpoint + " " + hex.EncodeToString(token[:8])
+}
+
+// cachedEntry returns the cache key and any cached entry for a request, or "" if it can't be cached
+func (c *Client) cachedEntry(method string, endpoint string) (string, *CacheEntry) {
+
+	if c.cache == nil || method != "GET" {
+		return "", nil
+	}
+
+	key := c.cacheKey(endpoint)
+	if entry, ok := c.cache.Get(key); ok {
+		return key, entry
+	}
+
+	return key, nil
+}
+
+// freshEntry returns true if an entry can be served without revalidating it
+func (c *Client) freshEntry(entry *CacheEntry) bool {
+	return entry != nil && c.cacheMaxAge > 0 && time.Since(entry.StoredAt) < c.cacheMaxAge
+}
+
+// cacheableEntry reads a successful response which can be revalidated into a cache entry, leaving its body
+// readable. The entry is only stored once the response has been decoded, so that bad bodies aren't cached.
+func (c *Client) cacheableEntry(resp *http.Response) (*CacheEntry, error) {
+
+	etag := resp.Header.Get("ETag")
+	lastModified := resp.Header.Get("Last-Modified")
+	if resp.StatusCode != http.StatusOK || (etag == "" && lastModified == "" && c.cacheMaxAge <= 0) {
+		return nil, nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	return &CacheEntry{
+		ETag:         etag,
+		LastModified: lastModified,
+		Body:         body,
+		StoredAt:     time.Now(),
+	}, nil
+}
+
+// invalidateCache removes the cached responses affected by a successful write to endpoint:
+// the resource itself, and the collection it belongs to
+func (c *Client) invalidateCache(method string, endpoint string) {
+
+	if c.cache == nil || method == "GET" || method == "HEAD" || method == "OPTIONS" {
+		return
+	}
+
+	// Writes to /campaigns/1/characters/2 invalidate every cached page of /campaigns/1/characters
+	path := strings.SplitN(endpoint, "?", 2)[0]
+	path = strings.TrimSuffix(path, "/")
+	if i := strings.LastIndex(path, "/"); i > 0 {
+		if _, err := strconv.Atoi(path[i+1:]); err == nil {
+			path = path[:i]
+		}
+	}
+
+	c.cache.DeletePrefix(c.BaseURL + path)
+}
+
+// countCache records how a GET request was served
+func (c *Client) countCache(count func(stats *CacheStats)) {
+
+	c.cacheMu.Lock()
+	defer c.cacheMu.Unlock()
+
+	count(&c.cacheStats)
+}
+
+// conditionalHeader returns the headers used to revalidate an entry
+func (e *CacheEntry) conditionalHeader() http.Header {
+
+	header := http.Header{}
+	if e == nil {
+		return header
+	}
+
+	if e.ETag != "" {
+		header.Set("If-None-Match", e.ETag)
+	}
+	if e.LastModified != "" {
+		header.Set("If-Modified-Since", e.LastModified)
+	}
+
+	return header
+}
+
+// decode decodes the cached body into a Response whose Data is v
+func (e *CacheEntry) decode(v interface{}) (*Response, error) {
+
+	fullResponse := Response{
+		Data: v,
+	}
+
+	if err := json.Unmarshal(e.Body, &fullResponse); err != nil {
+		return nil, err
+	}
+
+	return &fullResponse, nil
+}
+
+// NewLRUCache returns an in-memory cache holding up to size entries. Defaults to DefaultCacheSize.
+func NewLRUCache(size int) *LRUCache {
+
+	if size <= 0 {
+		size = DefaultCacheSize
+	}
+
+	return &LRUCache{
+		size:    size,
+		entries: make(map[string]*list.Element),
+		order:   list.New(),
+	}
+}
+
+// Get implements Cache
+func (l *LRUCache) Get(key string) (*CacheEntry, bool) {
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	element, ok := l.entries[key]
+	if !ok {
+		return nil, false
+	}
+	l.order.MoveToFront(element)
+
+	return element.Value.(*lruItem).entry, true
+}
+
+// Set implements Cache
+func (l *LRUCache) Set(key string, entry *CacheEntry) {
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if element, ok := l.entries[key]; ok {
+		element.Value.(*lruItem).entry = entry
+		l.order.MoveToFront(element)
+		return
+	}
+
+	l.entries[key] = l.order.PushFront(&lruItem{key: key, entry: entry})
+
+	for l.order.Len() > l.size {
+		oldest := l.order.Back()
+		l.order.Remove(oldest)
+		delete(l.entries, oldest.Value.(*lruItem).key)
+	}
+}
+
+// DeletePrefix implements Cache
+func (l *LRUCache) DeletePrefix(prefix string) {
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for key, element := range l.entries {
+		if strings.HasPrefix(key, prefix) {
+			l.order.Remove(element)
+			delete(l.entries, key)
+		}
+	}
+}
+
+// Len returns the number of cached entries
+func (l *LRUCache) Len() int {
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.order.Len()
+}
+
+// NewDiskCache returns a cache which keeps entries in dir. The directory is created on the first write.
+func NewDiskCache(dir string) *DiskCache {
+	return &DiskCache{
+		dir: dir,
+	}
+}
+
+// Get implements Cache
+func (d *DiskCache) Get(key string) (*CacheEntry, bool) {
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	stored, err := d.read(d.path(key))
+	if err != nil || stored.Key != key || stored.Entry == nil {
+		return nil, false
+	}
+
+	return stored.Entry, true
+}
+
+// Set implements Cache
+func (d *DiskCache) Set(key string, entry *CacheEntry) {
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	encoded, err := json.Marshal(diskEntry{Key: key, Entry: entry})
+	if err != nil {
+		return
+	}
+
+	if err = os.MkdirAll(d.dir, 0750); err != nil {
+		return
+	}
+
+	// Write to a temporary file first so that readers never see a partial entry
+	tmp, err := os.CreateTemp(d.dir, ".tmp-*")
+	if err != nil {
+		return
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err = tmp.Write(encoded); err != nil {
+		tmp.Close()
+		return
+	}
+	if err = tmp.Close(); err != nil {
+		return
+	}
+
+	_ = os.Rename(tmp.Name(), d.path(key))
+}
+
+// DeletePrefix implements Cache
+func (d *DiskCache) DeletePrefix(prefix string) {
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	paths, err := filepath.Glob(filepath.Join(d.dir, "*.json"))
+	if err != nil {
+		return
+	}
+
+	for _, path := range paths {
+		stored, err := d.read(path)
+		if err == nil && !strings.HasPrefix(stored.Key, prefix) {
+			continue
+		}
+		// Unreadable entries are removed too, since they can never be served
+		_ = os.Remove(path)
+	}
+}
+
+// path returns the file an entry is kept in
+func (d *DiskCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(d.dir, hex.EncodeToString(sum[:])+".json")
+}
+
+// read loads an entry file; callers must hold the lock
+func (d *DiskCache) read(path string) (*diskEntry, error) {
+
+	encoded, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+
+	stored := &diskEntry{}
+	return stored, json.Unmarshal(encoded, stored)
+}
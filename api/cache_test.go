@@ -0,0 +1,297 @@
+package kanka
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// cacheTestServer serves a character with an ETag, which changes whenever the character is written
+type cacheTestServer struct {
+	*httptest.Server
+	mu          sync.Mutex
+	version     int
+	requests    int
+	notModified int
+}
+
+func newCacheTestServer(t *testing.T) *cacheTestServer {
+
+	s := &cacheTestServer{version: 1}
+
+	s.Server = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		s.requests++
+
+		if req.Method != "GET" {
+			s.version++
+			res.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		etag := `"v` + strconv.Itoa(s.version) + `"`
+		if req.Header.Get("If-None-Match") == etag {
+			s.notModified++
+			res.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		res.Header().Set("Content-Type", "application/json")
+		res.Header().Set("ETag", etag)
+		res.WriteHeader(200)
+		_, err := res.Write([]byte(`{"data": {"id": 1, "name": "Version ` + strconv.Itoa(s.version) + `"}}`))
+		assert.NoError(t, err)
+	}))
+
+	return s
+}
+
+// counts returns the number of requests and 304 responses served so far
+func (s *cacheTestServer) counts() (int, int) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.requests, s.notModified
+}
+
+func TestCacheRevalidation(t *testing.T) {
+
+	testServer := newCacheTestServer(t)
+	defer testServer.Close()
+
+	config := DefaultConfig()
+	config.BaseURL = testServer.URL
+	config.ForceTLS = false
+	config.Cache = NewLRUCache(0)
+
+	client := NewClient(config)
+	ctx := context.Background()
+
+	// The first request is a full fetch
+	character, err := client.Characters(1).GetCharacter(ctx, 1)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "Version 1", character.Name)
+	}
+
+	// The second is revalidated and served from the cache
+	character, err = client.Characters(1).GetCharacter(ctx, 1)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "Version 1", character.Name)
+	}
+
+	requests, notModified := testServer.counts()
+	assert.Equal(t, 2, requests)
+	assert.Equal(t, 1, notModified)
+	assert.Equal(t, CacheStats{Revalidations: 1, Misses: 1}, client.CacheStats())
+
+	// Writes invalidate the resource, so the next request is a full fetch again
+	assert.NoError(t, client.Characters(1).DeleteCharacter(ctx, 1))
+
+	character, err = client.Characters(1).GetCharacter(ctx, 1)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "Version 2", character.Name)
+	}
+
+	requests, notModified = testServer.counts()
+	assert.Equal(t, 4, requests)
+	assert.Equal(t, 1, notModified)
+	assert.Equal(t, CacheStats{Revalidations: 1, Misses: 2}, client.CacheStats())
+}
+
+func TestCacheMaxAge(t *testing.T) {
+
+	testServer := newCacheTestServer(t)
+	defer testServer.Close()
+
+	config := DefaultConfig()
+	config.BaseURL = testServer.URL
+	config.ForceTLS = false
+	config.Cache = NewLRUCache(0)
+	config.CacheMaxAge = time.Minute
+
+	client := NewClient(config)
+	ctx := context.Background()
+
+	// Fresh entries are served without making a request or taking a rate-limit slot
+	for i := 0; i < 3; i++ {
+		_, err := client.Characters(1).GetCharacter(ctx, 1)
+		assert.NoError(t, err)
+	}
+
+	requests, _ := testServer.counts()
+	assert.Equal(t, 1, requests)
+	assert.Equal(t, CacheStats{Hits: 2, Misses: 1}, client.CacheStats())
+	assert.Equal(t, 29, client.RateLimiter().Available())
+}
+
+func TestCacheBadResponses(t *testing.T) {
+
+	// The first response is an HTML error page; the ones after it are JSON
+	requests := 0
+	conditional := 0
+	testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		requests++
+		if req.Header.Get("If-None-Match") != "" {
+			conditional++
+		}
+
+		if requests == 1 {
+			res.Header().Set("Content-Type", "text/html")
+			res.Header().Set("ETag", `"html"`)
+			res.WriteHeader(200)
+			_, err := res.Write([]byte("<html>Maintenance</html>"))
+			assert.NoError(t, err)
+			return
+		}
+
+		res.Header().Set("Content-Type", "application/json")
+		res.Header().Set("ETag", `"json"`)
+		res.WriteHeader(200)
+		_, err := res.Write([]byte(`{"data": {"id": 1, "name": "Jonathan Green"}}`))
+		assert.NoError(t, err)
+	}))
+	defer testServer.Close()
+
+	config := DefaultConfig()
+	config.BaseURL = testServer.URL
+	config.ForceTLS = false
+	config.Cache = NewLRUCache(0)
+	config.CacheMaxAge = time.Minute
+
+	client := NewClient(config)
+	ctx := context.Background()
+
+	// Responses which fail to decode aren't cached, so the next request reaches Kanka
+	_, err := client.Characters(1).GetCharacter(ctx, 1)
+	assert.Error(t, err)
+
+	character, err := client.Characters(1).GetCharacter(ctx, 1)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "Jonathan Green", character.Name)
+	}
+	assert.Equal(t, 2, requests)
+	assert.Equal(t, 0, conditional)
+
+	// Once a response has decoded, it is cached
+	_, err = client.Characters(1).GetCharacter(ctx, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, requests)
+}
+
+func TestCacheTokens(t *testing.T) {
+
+	testServer := newCacheTestServer(t)
+	defer testServer.Close()
+
+	config := DefaultConfig()
+	config.BaseURL = testServer.URL
+	config.ForceTLS = false
+	config.Cache = NewLRUCache(0)
+	config.CacheMaxAge = time.Minute
+
+	// Clients with different tokens never share responses
+	config.Token = "first"
+	_, err := NewClient(config).Characters(1).GetCharacter(context.Background(), 1)
+	assert.NoError(t, err)
+
+	config.Token = "second"
+	_, err = NewClient(config).Characters(1).GetCharacter(context.Background(), 1)
+	assert.NoError(t, err)
+
+	requests, _ := testServer.counts()
+	assert.Equal(t, 2, requests)
+	assert.Equal(t, 2, config.Cache.(*LRUCache).Len())
+}
+
+func TestInvalidateCache(t *testing.T) {
+
+	cache := NewLRUCache(0)
+	client := NewClient(&Config{BaseURL: "https://example.com", Cache: cache})
+
+	for _, endpoint := range []string{
+		"/campaigns/1/characters",
+		"/campaigns/1/characters?page=2",
+		"/campaigns/1/characters/2",
+		"/campaigns/1/locations/2",
+	} {
+		cache.Set(client.cacheKey(endpoint), &CacheEntry{})
+	}
+
+	// Writing to an object invalidates it and its collection
+	client.invalidateCache("PUT", "/campaigns/1/characters/2")
+	assert.Equal(t, 1, cache.Len())
+
+	_, ok := cache.Get(client.cacheKey("/campaigns/1/locations/2"))
+	assert.True(t, ok)
+
+	// Reads invalidate nothing
+	client.invalidateCache("GET", "/campaigns/1/locations/2")
+	assert.Equal(t, 1, cache.Len())
+}
+
+func TestLRUCache(t *testing.T) {
+
+	cache := NewLRUCache(2)
+	cache.Set("a", &CacheEntry{ETag: "a"})
+	cache.Set("b", &CacheEntry{ETag: "b"})
+
+	// Using an entry keeps it from being evicted
+	_, ok := cache.Get("a")
+	assert.True(t, ok)
+
+	cache.Set("c", &CacheEntry{ETag: "c"})
+	assert.Equal(t, 2, cache.Len())
+
+	_, ok = cache.Get("b")
+	assert.False(t, ok)
+
+	entry, ok := cache.Get("a")
+	if assert.True(t, ok) {
+		assert.Equal(t, "a", entry.ETag)
+	}
+
+	cache.DeletePrefix("a")
+	_, ok = cache.Get("a")
+	assert.False(t, ok)
+	assert.Equal(t, 1, cache.Len())
+}
+
+func TestDiskCache(t *testing.T) {
+
+	dir := filepath.Join(t.TempDir(), "cache")
+	cache := NewDiskCache(dir)
+
+	// Missing entries are misses
+	_, ok := cache.Get("https://example.com/campaigns/1")
+	assert.False(t, ok)
+
+	// Entries survive being reopened
+	stored := time.Date(2021, time.January, 1, 12, 0, 0, 0, time.UTC)
+	cache.Set("https://example.com/campaigns/1 abc", &CacheEntry{ETag: `"1"`, Body: []byte(`{}`), StoredAt: stored})
+	cache.Set("https://example.com/campaigns/2 abc", &CacheEntry{ETag: `"2"`, Body: []byte(`{}`), StoredAt: stored})
+
+	cache = NewDiskCache(dir)
+	entry, ok := cache.Get("https://example.com/campaigns/1 abc")
+	if assert.True(t, ok) {
+		assert.Equal(t, `"1"`, entry.ETag)
+		assert.Equal(t, []byte(`{}`), entry.Body)
+		assert.Equal(t, stored, entry.StoredAt)
+	}
+
+	cache.DeletePrefix("https://example.com/campaigns/1")
+	_, ok = cache.Get("https://example.com/campaigns/1 abc")
+	assert.False(t, ok)
+	_, ok = cache.Get("https://example.com/campaigns/2 abc")
+	assert.True(t, ok)
+}
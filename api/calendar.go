@@ -8,9 +8,7 @@ import (
 
 // Calendars is used to query the calendars endpoints
 type Calendars struct {
-	client     *Client
-	campaignID int
-	urlPrefix  string
+	*EntityService[Calendar, CalendarRequest]
 }
 
 // Calendar is used to serialize an calendar object
@@ -50,6 +48,31 @@ type Calendar struct {
 	UpdatedBy int       `json:"updated_by"`
 }
 
+// CalendarRequest is used to create or update a calendar
+type CalendarRequest struct {
+	Name  string  `json:"name"`
+	Entry *string `json:"entry,omitempty"`
+	Date  *string `json:"date,omitempty"`
+	Type  *string `json:"type,omitempty"`
+
+	Weekdays []string          `json:"weekdays,omitempty"`
+	Months   []Month           `json:"months,omitempty"`
+	Years    map[string]string `json:"years,omitempty"`
+
+	Moons       []Moon   `json:"moons,omitempty"`
+	Seasons     []Season `json:"seasons,omitempty"`
+	StartOffset *int     `json:"start_offset,omitempty"`
+
+	Suffix         *string `json:"suffix,omitempty"`
+	HasLeapYear    *bool   `json:"has_leap_year,omitempty"`
+	LeapYearAmount *int    `json:"leap_year_amount,omitempty"`
+	LeapYearMonth  *int    `json:"leap_year_month,omitempty"`
+	LeapYearOffset *int    `json:"leap_year_offset,omitempty"`
+	LeapYearStart  *int    `json:"leap_year_start,omitempty"`
+
+	IsPrivate *bool `json:"is_private,omitempty"`
+}
+
 // Month is used to serialize a month object
 type Month struct {
 	Name   string `json:"name"`
@@ -77,37 +100,135 @@ func init() {
 		calendar, _ := client.Calendars(campaignID).GetCalendar(ctx, entityID)
 		return calendar.Name
 	})
+	addKnownSyncType("calendar", func(ctx context.Context, client *Client, campaignID int, opts *ListOptions) ([]SyncRecord, error) {
+		records := []SyncRecord{}
+		err := client.Calendars(campaignID).Iterate(ctx, opts, func(calendar *Calendar) error {
+			records = append(records, newSyncRecord(calendar))
+			return nil
+		})
+		return records, err
+	})
+	addKnownEntityType("calendar", func(ctx context.Context, client *Client, campaignID int, id int) (Entity, error) {
+		return client.Calendars(campaignID).GetCalendar(ctx, id)
+	})
 }
 
 // Calendars returns a handle of the calendars endpoint
 func (c *Client) Calendars(campaignID int) *Calendars {
 	return &Calendars{
-		client:     c,
-		campaignID: campaignID,
-		urlPrefix:  fmt.Sprintf("/campaigns/%d/calendars", campaignID),
+		EntityService: newEntityService[Calendar, CalendarRequest](c, campaignID, "calendars"),
 	}
 }
 
 // GetCalendars can return information about all calendars
 func (c *Calendars) GetCalendars(ctx context.Context) (*[]Calendar, error) {
+	return c.GetAll(ctx)
+}
 
-	var err error
-	resp := []Calendar{}
-	url := c.urlPrefix
+// GetCalendar can return information about a single calendar
+func (c *Calendars) GetCalendar(ctx context.Context, id int) (*Calendar, error) {
+	return c.Get(ctx, id)
+}
 
-	for len(url) > 0 && err == nil {
-		page := []Calendar{}
-		url, err = c.client.makeRequest(ctx, "GET", url, &page)
-		resp = append(resp, page...)
-	}
+// GetCalendarWithPosts can return information about a single calendar along with all of its posts
+func (c *Calendars) GetCalendarWithPosts(ctx context.Context, id int) (*Calendar, *[]EntityPost, error) {
+	return getWithPosts(ctx, c.EntityService, id)
+}
 
-	return &resp, err
+// CreateCalendar can create a new calendar and return the result
+func (c *Calendars) CreateCalendar(ctx context.Context, calendar *CalendarRequest) (*Calendar, error) {
+	return c.Create(ctx, calendar)
 }
 
-// GetCalendar can return information about a single calendar
-func (c *Calendars) GetCalendar(ctx context.Context, id int) (*Calendar, error) {
+// UpdateCalendar can update a calendar and return the result
+func (c *Calendars) UpdateCalendar(ctx context.Context, id int, calendar *CalendarRequest) (*Calendar, error) {
+	return c.Update(ctx, id, calendar)
+}
+
+// PatchCalendar can update only the given fields of a calendar and return the result
+func (c *Calendars) PatchCalendar(ctx context.Context, id int, fields map[string]interface{}) (*Calendar, error) {
+	return c.Patch(ctx, id, fields)
+}
+
+// DeleteCalendar can delete a calendar
+func (c *Calendars) DeleteCalendar(ctx context.Context, id int) error {
+	return c.Delete(ctx, id)
+}
+
+// GetEvents can return information about all entity events (reminders) attached to a calendar
+func (c *Calendars) GetEvents(ctx context.Context, id int) (*[]EntityEvent, error) {
+	return getAll[EntityEvent](ctx, c.client, fmt.Sprintf("%s/%d/calendar_events", c.urlPrefix, id))
+}
+
+// ListEvents returns a pager which fetches the entity events attached to a calendar one page at a time
+func (c *Calendars) ListEvents(id int, opts *ListOptions) *EntityEventPager {
+	return newTypedPager[EntityEvent](c.client, fmt.Sprintf("%s/%d/calendar_events", c.urlPrefix, id), opts)
+}
+
+// GetID implements Entity
+func (c *Calendar) GetID() int {
+	return c.ID
+}
 
-	resp := Calendar{}
-	_, err := c.client.makeRequest(ctx, "GET", fmt.Sprintf("%s/%d", c.urlPrefix, id), &resp)
-	return &resp, err
+// GetEntityID implements Entity
+func (c *Calendar) GetEntityID() int {
+	return c.EntityID
 }
+
+// GetName implements Entity
+func (c *Calendar) GetName() string {
+	return c.Name
+}
+
+// EntityType implements Entity
+func (c *Calendar) EntityType() string {
+	return "calendar"
+}
+
+// GetEntry implements Entity
+func (c *Calendar) GetEntry() string {
+	return c.Entry
+}
+
+// GetType implements Entity
+func (c *Calendar) GetType() string {
+	return c.Type
+}
+
+// GetIsPrivate implements Entity
+func (c *Calendar) GetIsPrivate() bool {
+	return c.IsPrivate
+}
+
+// GetImage implements Entity
+func (c *Calendar) GetImage() string {
+	return c.Image
+}
+
+// GetImageThumb implements Entity
+func (c *Calendar) GetImageThumb() string {
+	return c.ImageThumb
+}
+
+// GetCreatedAt implements Entity
+func (c *Calendar) GetCreatedAt() time.Time {
+	return c.CreatedAt
+}
+
+// GetCreatedBy implements Entity
+func (c *Calendar) GetCreatedBy() int {
+	return c.CreatedBy
+}
+
+// GetUpdatedAt implements Entity
+func (c *Calendar) GetUpdatedAt() time.Time {
+	return c.UpdatedAt
+}
+
+// GetUpdatedBy implements Entity
+func (c *Calendar) GetUpdatedBy() int {
+	return c.UpdatedBy
+}
+
+// CalendarPager is used to iterate over pages of calendars
+type CalendarPager = Pager[Calendar]
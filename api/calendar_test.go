@@ -149,3 +149,39 @@ func TestGetCalendar(t *testing.T) {
 		assert.Equal(t, 1, c.UpdatedBy)
 	}
 }
+
+func TestGetCalendarEvents(t *testing.T) {
+
+	testServer, config := mockTestServer()
+	defer testServer.Close()
+	client := NewClient(config)
+	ctx := context.Background()
+
+	events, err := client.Calendars(1).GetEvents(ctx, 1)
+
+	// Events of every entity are returned
+	if assert.NoError(t, err) {
+		assert.Len(t, *events, 2)
+		assert.Equal(t, 4, (*events)[0].EntityID)
+		assert.Equal(t, "Birthday", (*events)[0].Comment)
+		assert.Equal(t, 5, (*events)[1].EntityID)
+		assert.Equal(t, "Festival of Lights", (*events)[1].Comment)
+	}
+}
+
+func TestListCalendarEvents(t *testing.T) {
+
+	testServer, config := mockTestServer()
+	defer testServer.Close()
+	client := NewClient(config)
+	ctx := context.Background()
+
+	pager := client.Calendars(1).ListEvents(1, nil)
+
+	if assert.True(t, pager.Next(ctx)) {
+		assert.Len(t, pager.Page(), 2)
+		assert.Equal(t, 1, pager.Page()[0].ID)
+	}
+	assert.False(t, pager.Next(ctx))
+	assert.NoError(t, pager.Err())
+}
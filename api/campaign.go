@@ -54,18 +54,12 @@ func (c *Client) Campaigns() *Campaigns {
 
 // GetCampaigns can return information about all campaigns
 func (c *Campaigns) GetCampaigns(ctx context.Context) (*[]Campaign, error) {
+	return getAll[Campaign](ctx, c.client, c.urlPrefix)
+}
 
-	var err error
-	resp := []Campaign{}
-	url := c.urlPrefix
-
-	for len(url) > 0 && err == nil {
-		page := []Campaign{}
-		url, err = c.client.makeRequest(ctx, "GET", url, &page)
-		resp = append(resp, page...)
-	}
-
-	return &resp, err
+// List returns a pager which fetches campaigns one page at a time
+func (c *Campaigns) List(opts *ListOptions) *CampaignPager {
+	return newTypedPager[Campaign](c.client, c.urlPrefix, opts)
 }
 
 // GetCampaign returns information about a single campaign
@@ -75,3 +69,6 @@ func (c *Campaigns) GetCampaign(ctx context.Context, id int) (*Campaign, error)
 	_, err := c.client.makeRequest(ctx, "GET", fmt.Sprintf("/%s/%d", c.urlPrefix, id), &resp)
 	return &resp, err
 }
+
+// CampaignPager is used to iterate over pages of campaigns
+type CampaignPager = Pager[Campaign]
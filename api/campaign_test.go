@@ -54,6 +54,24 @@ func TestGetCampaigns(t *testing.T) {
 	}
 }
 
+func TestListCampaigns(t *testing.T) {
+
+	testServer, config := mockTestServer()
+	defer testServer.Close()
+	client := NewClient(config)
+	ctx := context.Background()
+
+	pager := client.Campaigns().List(nil)
+
+	// There is only one page of campaigns
+	if assert.True(t, pager.Next(ctx)) {
+		assert.Len(t, pager.Page(), 1)
+		assert.Equal(t, 1, pager.Page()[0].ID)
+	}
+	assert.False(t, pager.Next(ctx))
+	assert.NoError(t, pager.Err())
+}
+
 func TestGetCampaign(t *testing.T) {
 
 	testServer, config := mockTestServer()
@@ -3,14 +3,13 @@ package kanka
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"time"
 )
 
 // Characters is used to query the characters endpoints
 type Characters struct {
-	client     *Client
-	campaignID int
-	urlPrefix  string
+	*EntityService[Character, CharacterRequest]
 }
 
 // Character is used to serialize a character object
@@ -43,6 +42,22 @@ type Character struct {
 	UpdatedBy int       `json:"updated_by"`
 }
 
+// CharacterRequest is used to create or update a character
+type CharacterRequest struct {
+	Name       string  `json:"name"`
+	Title      *string `json:"title,omitempty"`
+	Entry      *string `json:"entry,omitempty"`
+	LocationID *int    `json:"location_id,omitempty"`
+	Age        *string `json:"age,omitempty"`
+	Sex        *string `json:"sex,omitempty"`
+	RaceID     *int    `json:"race_id,omitempty"`
+	Type       *string `json:"type,omitempty"`
+	FamilyID   *int    `json:"family_id,omitempty"`
+	IsDead     *bool   `json:"is_dead,omitempty"`
+
+	IsPrivate *bool `json:"is_private,omitempty"`
+}
+
 // Trait is used to serialize a character trait object
 type Trait struct {
 	ID           int    `json:"id"`
@@ -53,42 +68,217 @@ type Trait struct {
 	DefaultOrder int    `json:"default_order"`
 }
 
+// CharacterFilter is used to filter characters in ListOptions
+type CharacterFilter struct {
+	IsDead     *bool
+	RaceID     int
+	FamilyID   int
+	LocationID int
+	Sex        string
+}
+
+// Apply implements Filter
+func (f CharacterFilter) Apply(q url.Values) {
+	setBool(q, "is_dead", f.IsDead)
+	setInt(q, "race_id", f.RaceID)
+	setInt(q, "family_id", f.FamilyID)
+	setInt(q, "location_id", f.LocationID)
+	setString(q, "sex", f.Sex)
+}
+
 func init() {
 	addKnownLinkType("character", func(ctx context.Context, client *Client, campaignID int, entityID int) string {
 		character, _ := client.Characters(campaignID).GetCharacter(ctx, entityID)
 		return character.Name
 	})
+	addKnownSyncType("character", func(ctx context.Context, client *Client, campaignID int, opts *ListOptions) ([]SyncRecord, error) {
+		records := []SyncRecord{}
+		err := client.Characters(campaignID).Iterate(ctx, opts, func(character *Character) error {
+			records = append(records, newSyncRecord(character))
+			return nil
+		})
+		return records, err
+	})
+	addKnownEntityType("character", func(ctx context.Context, client *Client, campaignID int, id int) (Entity, error) {
+		return client.Characters(campaignID).GetCharacter(ctx, id)
+	})
 }
 
 // Characters returns a handle on the characters endpoint
 func (c *Client) Characters(campaignID int) *Characters {
 	return &Characters{
-		client:     c,
-		campaignID: campaignID,
-		urlPrefix:  fmt.Sprintf("/campaigns/%d/characters", campaignID),
+		EntityService: newEntityService[Character, CharacterRequest](c, campaignID, "characters"),
 	}
 }
 
 // GetCharacters can return information about all characters
 func (c *Characters) GetCharacters(ctx context.Context) (*[]Character, error) {
+	return c.GetAll(ctx)
+}
+
+// GetCharacter can return information about a single character
+func (c *Characters) GetCharacter(ctx context.Context, id int) (*Character, error) {
+	return c.Get(ctx, id)
+}
+
+// GetCharacterWithPosts can return information about a single character along with all of its posts
+func (c *Characters) GetCharacterWithPosts(ctx context.Context, id int) (*Character, *[]EntityPost, error) {
+	return getWithPosts(ctx, c.EntityService, id)
+}
+
+// CreateCharacter can create a new character and return the result
+func (c *Characters) CreateCharacter(ctx context.Context, character *CharacterRequest) (*Character, error) {
+	return c.Create(ctx, character)
+}
 
-	var err error
-	resp := []Character{}
-	url := c.urlPrefix
+// UpdateCharacter can update a character and return the result
+func (c *Characters) UpdateCharacter(ctx context.Context, id int, character *CharacterRequest) (*Character, error) {
+	return c.Update(ctx, id, character)
+}
 
-	for len(url) > 0 && err == nil {
-		page := []Character{}
-		url, err = c.client.makeRequest(ctx, "GET", url, &page)
-		resp = append(resp, page...)
+// PatchCharacter can update only the given fields of a character and return the result
+func (c *Characters) PatchCharacter(ctx context.Context, id int, fields map[string]interface{}) (*Character, error) {
+	return c.Patch(ctx, id, fields)
+}
+
+// DeleteCharacter can delete a character
+func (c *Characters) DeleteCharacter(ctx context.Context, id int) error {
+	return c.Delete(ctx, id)
+}
+
+// GetInventory can return the inventory of a given character joined with the items it refers to.
+// See Client.InventoryOf for how missing items are handled.
+func (c *Characters) GetInventory(ctx context.Context, id int) ([]InventoryItem, error) {
+
+	character, err := c.GetCharacter(ctx, id)
+	if err != nil {
+		return nil, err
 	}
 
-	return &resp, err
+	return c.client.InventoryOf(ctx, c.campaignID, character.EntityID)
 }
 
-// GetCharacter can return information about a single character
-func (c *Characters) GetCharacter(ctx context.Context, id int) (*Character, error) {
+// GetGivenQuests can return information about all quests given by a given character, i.e. whose
+// CharacterID is the character. Use GetQuests for the quests the character takes part in as well.
+func (c *Characters) GetGivenQuests(ctx context.Context, id int) (*[]Quest, error) {
+	opts := &ListOptions{Filter: QuestFilter{CharacterID: id}}
+	return c.client.Quests(c.campaignID).getAllMatching(ctx, opts)
+}
+
+// GetQuests can return all quests a given character takes part in: the quests they give, and the quests
+// they are a quest character of. Kanka can't filter quests by their characters, so the characters of every
+// quest in the campaign are fetched, concurrently. If the characters of some quests can't be fetched, the
+// quests found in the others are still returned along with the error for the lowest failed quest ID.
+func (c *Characters) GetQuests(ctx context.Context, id int) ([]CharacterQuest, error) {
+
+	quests, err := c.client.Quests(c.campaignID).GetQuests(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int, 0, len(*quests))
+	for _, quest := range *quests {
+		ids = append(ids, quest.ID)
+	}
+
+	roles := make([][]QuestCharacter, len(ids))
+	errs := fetchMany(ctx, ids, nil, func(ctx context.Context, i int, questID int) error {
+		characters, err := c.client.Quests(c.campaignID).GetQuestCharacters(ctx, questID)
+		if err != nil {
+			return err
+		}
+		for _, character := range *characters {
+			if character.CharacterID == id {
+				roles[i] = append(roles[i], character)
+			}
+		}
+		return nil
+	})
 
-	resp := Character{}
-	_, err := c.client.makeRequest(ctx, "GET", fmt.Sprintf("%s/%d", c.urlPrefix, id), &resp)
-	return &resp, err
+	found := []CharacterQuest{}
+	for i, quest := range *quests {
+		if quest.CharacterID == id || len(roles[i]) > 0 {
+			found = append(found, CharacterQuest{Quest: quest, IsGiver: quest.CharacterID == id, Roles: roles[i]})
+		}
+	}
+
+	if questID, err := lowestError(errs); err != nil {
+		return found, fmt.Errorf("fetching characters of quest %d: %w", questID, err)
+	}
+
+	return found, nil
 }
+
+// GetJournals can return information about all journals (e.g. diary entries) written by a given character
+func (c *Characters) GetJournals(ctx context.Context, id int) (*[]Journal, error) {
+	opts := &ListOptions{Filter: JournalFilter{CharacterID: id}}
+	return c.client.Journals(c.campaignID).getAllMatching(ctx, opts)
+}
+
+// GetID implements Entity
+func (c *Character) GetID() int {
+	return c.ID
+}
+
+// GetEntityID implements Entity
+func (c *Character) GetEntityID() int {
+	return c.EntityID
+}
+
+// GetName implements Entity
+func (c *Character) GetName() string {
+	return c.Name
+}
+
+// EntityType implements Entity
+func (c *Character) EntityType() string {
+	return "character"
+}
+
+// GetEntry implements Entity
+func (c *Character) GetEntry() string {
+	return c.Entry
+}
+
+// GetType implements Entity
+func (c *Character) GetType() string {
+	return c.Type
+}
+
+// GetIsPrivate implements Entity
+func (c *Character) GetIsPrivate() bool {
+	return c.IsPrivate
+}
+
+// GetImage implements Entity
+func (c *Character) GetImage() string {
+	return c.Image
+}
+
+// GetImageThumb implements Entity
+func (c *Character) GetImageThumb() string {
+	return c.ImageThumb
+}
+
+// GetCreatedAt implements Entity
+func (c *Character) GetCreatedAt() time.Time {
+	return c.CreatedAt
+}
+
+// GetCreatedBy implements Entity
+func (c *Character) GetCreatedBy() int {
+	return c.CreatedBy
+}
+
+// GetUpdatedAt implements Entity
+func (c *Character) GetUpdatedAt() time.Time {
+	return c.UpdatedAt
+}
+
+// GetUpdatedBy implements Entity
+func (c *Character) GetUpdatedBy() int {
+	return c.UpdatedBy
+}
+
+// CharacterPager is used to iterate over pages of characters
+type CharacterPager = Pager[Character]
@@ -0,0 +1,101 @@
+package kanka
+
+import (
+	"context"
+	"fmt"
+)
+
+// CharacterDossier is a character along with everything related to it, as returned by Characters.GetDossier.
+// Race, Family and Location are nil if the character doesn't have one.
+type CharacterDossier struct {
+	Character *Character
+	Race      *Race
+	Family    *Family
+	Location  *Location
+
+	// Traits are the character's personality and appearance traits
+	Traits []Trait
+
+	Memberships []CharacterMembership
+	Items       []InventoryItem
+	Quests      []CharacterQuest
+}
+
+// GetDossier can return a character along with its race, family, location, traits, organisation memberships,
+// inventory and the quests it gives or takes part in. Once the character has been fetched, the rest is fetched
+// concurrently, sharing the client's rate-limiter. If some of it can't be fetched, the dossier is still returned
+// along with an error.
+func (c *Characters) GetDossier(ctx context.Context, id int) (*CharacterDossier, error) {
+
+	character, err := c.GetCharacter(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	dossier := &CharacterDossier{
+		Character: character,
+		Traits:    character.Traits,
+	}
+
+	err = fetchAll(ctx,
+		func(ctx context.Context) error {
+			if character.RaceID == 0 {
+				return nil
+			}
+			race, err := c.client.Races(c.campaignID).GetRace(ctx, character.RaceID)
+			if err != nil {
+				return fmt.Errorf("fetching race: %w", err)
+			}
+			dossier.Race = race
+			return nil
+		},
+		func(ctx context.Context) error {
+			if character.FamilyID == 0 {
+				return nil
+			}
+			family, err := c.client.Families(c.campaignID).GetFamily(ctx, character.FamilyID)
+			if err != nil {
+				return fmt.Errorf("fetching family: %w", err)
+			}
+			dossier.Family = family
+			return nil
+		},
+		func(ctx context.Context) error {
+			if character.LocationID == 0 {
+				return nil
+			}
+			location, err := c.client.Locations(c.campaignID).GetLocation(ctx, character.LocationID)
+			if err != nil {
+				return fmt.Errorf("fetching location: %w", err)
+			}
+			dossier.Location = location
+			return nil
+		},
+		func(ctx context.Context) error {
+			memberships, err := c.GetOrganisations(ctx, id)
+			dossier.Memberships = memberships
+			if err != nil {
+				return fmt.Errorf("fetching memberships: %w", err)
+			}
+			return nil
+		},
+		func(ctx context.Context) error {
+			items, err := c.client.InventoryOf(ctx, c.campaignID, character.EntityID)
+			dossier.Items = items
+			if err != nil {
+				return fmt.Errorf("fetching inventory: %w", err)
+			}
+			return nil
+		},
+		func(ctx context.Context) error {
+			quests, err := c.GetQuests(ctx, id)
+			dossier.Quests = quests
+			if err != nil {
+				return fmt.Errorf("fetching quests: %w", err)
+			}
+			return nil
+		},
+	)
+
+	return dossier, err
+}
@@ -0,0 +1,52 @@
+package kanka
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetDossier(t *testing.T) {
+
+	testServer, config := mockTestServer()
+	defer testServer.Close()
+	config.Retry = nil
+	client := NewClient(config)
+	ctx := context.Background()
+
+	dossier, err := client.Characters(1).GetDossier(ctx, 1)
+
+	// One of the character's organisations is missing, but the rest of the dossier is still returned
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.EqualError(t, err, "fetching memberships: fetching organisation 404: Non-2xx response: 404 Not Found")
+
+	if assert.NotNil(t, dossier) {
+		assert.Equal(t, "Jonathan Green", dossier.Character.Name)
+		assert.Len(t, dossier.Traits, 1)
+
+		if assert.NotNil(t, dossier.Race) {
+			assert.Equal(t, 3, dossier.Race.ID)
+		}
+		if assert.NotNil(t, dossier.Family) {
+			assert.Equal(t, 34, dossier.Family.ID)
+		}
+		if assert.NotNil(t, dossier.Location) {
+			assert.Equal(t, 4, dossier.Location.ID)
+		}
+
+		if assert.Len(t, dossier.Memberships, 2) && assert.NotNil(t, dossier.Memberships[0].Organisation) {
+			assert.Equal(t, "Tiamat Cultists", dossier.Memberships[0].Organisation.Name)
+		}
+		assert.Len(t, dossier.Items, 3)
+		if assert.Len(t, dossier.Quests, 1) {
+			assert.Equal(t, "Pelor's Quest", dossier.Quests[0].Quest.Name)
+			assert.Len(t, dossier.Quests[0].Roles, 2)
+		}
+	}
+
+	// Characters which can't be fetched return no dossier
+	dossier, err = client.Characters(1).GetDossier(ctx, 404)
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.Nil(t, dossier)
+}
@@ -2,6 +2,7 @@ package kanka
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
 	"time"
 
@@ -119,3 +120,69 @@ func TestGetCharacter(t *testing.T) {
 		assert.Equal(t, 0, tr.DefaultOrder)
 	}
 }
+
+func TestMarshalCharacter(t *testing.T) {
+
+	// Characters marshal with every field, e.g. for exports, while requests only send the fields which are set
+	encoded, err := json.Marshal(Character{ID: 1, Name: "Jonathan Green"})
+	if assert.NoError(t, err) {
+		assert.Contains(t, string(encoded), `"is_dead":false`)
+		assert.Contains(t, string(encoded), `"is_private":false`)
+		assert.Contains(t, string(encoded), `"location_id":0`)
+	}
+
+	encoded, err = json.Marshal(CharacterRequest{Name: "Jonathan Green"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, `{"name":"Jonathan Green"}`, string(encoded))
+	}
+}
+
+func TestGetCharacterRelations(t *testing.T) {
+
+	testServer, config := mockTestServer()
+	defer testServer.Close()
+	client := NewClient(config)
+	ctx := context.Background()
+	characters := client.Characters(1)
+
+	// The inventory belongs to the character's entity
+	inventory, err := characters.GetInventory(ctx, 1)
+	if assert.NoError(t, err) && assert.Len(t, inventory, 3) && assert.NotNil(t, inventory[0].Item) {
+		assert.Equal(t, "Spear", inventory[0].Item.Name)
+	}
+
+	quests, err := characters.GetGivenQuests(ctx, 1)
+	if assert.NoError(t, err) && assert.Len(t, *quests, 1) {
+		assert.Equal(t, "Pelor's Quest", (*quests)[0].Name)
+	}
+
+	// Character 1 doesn't give Pelor's Quest, but takes part in it twice
+	characterQuests, err := characters.GetQuests(ctx, 1)
+	if assert.NoError(t, err) && assert.Len(t, characterQuests, 1) {
+		assert.Equal(t, "Pelor's Quest", characterQuests[0].Quest.Name)
+		assert.False(t, characterQuests[0].IsGiver)
+		if assert.Len(t, characterQuests[0].Roles, 2) {
+			assert.Equal(t, "Reluctant guide", characterQuests[0].Roles[1].Description)
+		}
+	}
+
+	// Character 4 gives it, without being one of its characters
+	characterQuests, err = characters.GetQuests(ctx, 4)
+	if assert.NoError(t, err) && assert.Len(t, characterQuests, 1) {
+		assert.True(t, characterQuests[0].IsGiver)
+		assert.Empty(t, characterQuests[0].Roles)
+	}
+
+	// Characters taking part in no quests have none
+	characterQuests, err = characters.GetQuests(ctx, 2)
+	assert.NoError(t, err)
+	assert.Empty(t, characterQuests)
+
+	journals, err := characters.GetJournals(ctx, 1)
+	if assert.NoError(t, err) && assert.Len(t, *journals, 1) {
+		assert.Equal(t, "Session 2 - Descent into the Abyss", (*journals)[0].Name)
+	}
+
+	_, err = characters.GetInventory(ctx, 404)
+	assert.ErrorIs(t, err, ErrNotFound)
+}
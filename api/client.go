@@ -5,11 +5,14 @@ See https://kanka.io/docs/1.0 for API documentation.
 package kanka
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"strings"
+	"sync"
 	"time"
 )
 
@@ -24,12 +27,20 @@ const (
 
 // Client provides a client to the Kanka API
 type Client struct {
-	BaseURL                string
-	ForceTLS               bool
-	rateLimiter            chan int
-	rateLimitResetInterval time.Duration
-	token                  string
-	HTTPClient             *http.Client
+	BaseURL           string
+	ForceTLS          bool
+	rateLimiter       *RateLimiter
+	adaptiveRateLimit bool
+	rateLimitMu       sync.Mutex
+	rateLimitStatus   RateLimitStatus
+	retryPolicy       RetryPolicy
+	middleware        []Middleware
+	cache             Cache
+	cacheMaxAge       time.Duration
+	cacheMu           sync.Mutex
+	cacheStats        CacheStats
+	token             string
+	HTTPClient        *http.Client
 }
 
 // Config is used to configure the creation of a client
@@ -37,6 +48,11 @@ type Config struct {
 	BaseURL              string
 	ForceTLS             bool
 	MaxRequestsPerMinute time.Duration
+	AdaptiveRateLimit    bool
+	Retry                *RetryPolicy
+	Middleware           []Middleware
+	Cache                Cache
+	CacheMaxAge          time.Duration
 	Token                string
 	Timeout              time.Duration
 }
@@ -72,6 +88,8 @@ func DefaultConfig() *Config {
 	return &Config{
 		BaseURL:              KankaBaseURLV1,
 		MaxRequestsPerMinute: DefaultMaxRequestsPerMinute,
+		AdaptiveRateLimit:    true,
+		Retry:                DefaultRetryPolicy(),
 		Timeout:              DefaultTimeout,
 		ForceTLS:             true,
 	}
@@ -90,20 +108,31 @@ func NewClient(c *Config) *Client {
 		c.MaxRequestsPerMinute = DefaultMaxRequestsPerMinute
 	}
 
+	// Copy the retry policy so that later changes to the config don't affect the client
+	// A nil retry policy disables retries
+	retryPolicy := RetryPolicy{}
+	if c.Retry != nil {
+		retryPolicy = *c.Retry
+	}
+
 	// Create and return client
 	return &Client{
-		BaseURL:                c.BaseURL,
-		ForceTLS:               c.ForceTLS,
-		rateLimiter:            make(chan int, c.MaxRequestsPerMinute),
-		rateLimitResetInterval: time.Minute,
-		token:                  c.Token,
+		BaseURL:           c.BaseURL,
+		ForceTLS:          c.ForceTLS,
+		rateLimiter:       NewRateLimiter(int(c.MaxRequestsPerMinute), time.Minute),
+		adaptiveRateLimit: c.AdaptiveRateLimit,
+		retryPolicy:       retryPolicy,
+		middleware:        append([]Middleware{}, c.Middleware...),
+		cache:             c.Cache,
+		cacheMaxAge:       c.CacheMaxAge,
+		token:             c.Token,
 		HTTPClient: &http.Client{
 			Timeout: c.Timeout,
 		},
 	}
 }
 
-// SetRateLimitResetInterval adjusts how quickly requests are unblocked by the rate-limiter channel.
+// SetRateLimitResetInterval adjusts how quickly requests are unblocked by the rate-limiter.
 // This should almost never be modified. The rate-limiter receives MaxRequestsPerMinute from a Config
 // object which assumes that the reset interval is 1 minute. The rate-limiter will work incorrectly
 // if the reset interval is changed to anything other than 1 minute. The only reason to change the
@@ -113,13 +142,37 @@ func NewClient(c *Config) *Client {
 // Another more dangerous way to think about this function is that it effectively adjusts how long
 // 1 minute is considered by the rate-limiter.
 func (c *Client) SetRateLimitResetInterval(duration time.Duration) {
-	c.rateLimitResetInterval = duration
+	c.rateLimiter.SetInterval(duration)
+}
+
+// RateLimiter returns the client's rate-limiter, e.g. to reserve capacity for work done outside the client.
+// Every request made by the client waits on this rate-limiter.
+func (c *Client) RateLimiter() *RateLimiter {
+	return c.rateLimiter
 }
 
 // makeRequest is a convenience function to make a request at a given URL endpoint, and then decode the response into v
 // Populates the response into interface v
 // Returns URL of the next page (if one exists) and an error (if one exists)
 func (c *Client) makeRequest(ctx context.Context, method string, endpoint string, v interface{}) (string, error) {
+	return c.makeRequestWithBody(ctx, method, endpoint, nil, v)
+}
+
+// makeRequestWithBody behaves like makeRequest, but also encodes body as JSON and sends it with the request.
+// A nil body sends no request body at all.
+func (c *Client) makeRequestWithBody(ctx context.Context, method string, endpoint string, body interface{}, v interface{}) (string, error) {
+
+	resp, err := c.doRequest(ctx, method, endpoint, body, v)
+	if err != nil {
+		return "", err
+	}
+
+	return resp.nextPage(), nil
+}
+
+// doRequest makes a request at a given URL endpoint with an optional JSON body, and decodes the response into v
+// Returns the full response (whose Data is v) so that callers can inspect its links and metadata
+func (c *Client) doRequest(ctx context.Context, method string, endpoint string, body interface{}, v interface{}) (*Response, error) {
 
 	// Sometimes endpoint is just the path, e.g. /campaigns
 	// Sometimes, if we're paginating, it will be the full URL, e.g. https://example.com/campaigns
@@ -132,7 +185,7 @@ func (c *Client) makeRequest(ctx context.Context, method string, endpoint string
 	// Also for the latter case: make sure the base URL hasn't been modified, and then strip it
 	if strings.HasPrefix(endpoint, "http://") || strings.HasPrefix(endpoint, "https://") {
 		if !strings.HasPrefix(endpoint, c.BaseURL) {
-			return "", fmt.Errorf("Base URL in request to '%s' does not match %s", endpoint, c.BaseURL)
+			return nil, fmt.Errorf("Base URL in request to '%s' does not match %s", endpoint, c.BaseURL)
 		}
 		endpoint = strings.Replace(endpoint, c.BaseURL, "", 1)
 	}
@@ -142,44 +195,140 @@ func (c *Client) makeRequest(ctx context.Context, method string, endpoint string
 		c.BaseURL = strings.Replace(c.BaseURL, "http", "https", 1)
 	}
 
+	// Encode the request body if there is one
+	var encoded []byte
+	if body != nil {
+		var err error
+		if encoded, err = json.Marshal(body); err != nil {
+			return nil, err
+		}
+	}
+
+	// Serve GET requests from the cache if the cached response is recent enough,
+	// and otherwise ask Kanka to only send the response if it has changed
+	cacheKey, cached := c.cachedEntry(method, endpoint)
+	if c.freshEntry(cached) {
+		c.countCache(func(stats *CacheStats) { stats.Hits++ })
+		return cached.decode(v)
+	}
+	header := cached.conditionalHeader()
+
+	// Make the request, retrying failed attempts for as long as the retry policy allows
+	for attempt := 1; ; attempt++ {
+
+		var wait time.Duration
+
+		// Self-rate limit; this will block if we request too fast
+		if err := c.rateLimiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+
+		req, resp, err := c.sendRequest(ctx, method, endpoint, encoded, header)
+		if err != nil {
+
+			// Network errors; a cancelled context is never retried
+			if ctx.Err() != nil || !c.retryPolicy.shouldRetryError(method, attempt, err) {
+				return nil, err
+			}
+			wait = c.retryPolicy.backoff(attempt)
+
+		} else {
+
+			// Keep track of the quota Kanka reports, successful or not
+			c.observeRateLimit(resp.Header)
+
+			// Unchanged responses are served from the cache, and kept for another CacheMaxAge
+			if resp.StatusCode == http.StatusNotModified && cached != nil {
+				resp.Body.Close()
+				c.cache.Set(cacheKey, &CacheEntry{
+					ETag:         cached.ETag,
+					LastModified: cached.LastModified,
+					Body:         cached.Body,
+					StoredAt:     time.Now(),
+				})
+				c.countCache(func(stats *CacheStats) { stats.Revalidations++ })
+				return cached.decode(v)
+			}
+
+			var entry *CacheEntry
+			if cacheKey != "" && resp.StatusCode == http.StatusOK {
+				c.countCache(func(stats *CacheStats) { stats.Misses++ })
+				if entry, err = c.cacheableEntry(resp); err != nil {
+					return nil, err
+				}
+			}
+
+			fullResponse, err := c.decodeResponse(req, resp, v)
+			if err == nil {
+				if entry != nil {
+					c.cache.Set(cacheKey, entry)
+				}
+				c.invalidateCache(method, endpoint)
+				return fullResponse, nil
+			}
+
+			// Non-2xx responses
+			apiErr, ok := err.(*APIError)
+			if !ok || !c.retryPolicy.shouldRetryStatus(method, attempt, apiErr.StatusCode) {
+				return nil, err
+			}
+			wait = c.retryPolicy.delay(attempt, resp.Header, apiErr)
+		}
+
+		if err := sleepContext(ctx, wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// sendRequest builds a single request to the given endpoint with any extra headers, and sends it.
+// The request is returned along with the response, as middleware may return responses without one.
+func (c *Client) sendRequest(ctx context.Context, method string, endpoint string, body []byte, header http.Header) (*http.Request, *http.Response, error) {
+
+	// A fresh body reader is needed for every attempt
+	var reqBody io.Reader
+	if body != nil {
+		reqBody = bytes.NewReader(body)
+	}
+
 	// Setup the request
-	req, err := http.NewRequest(method, fmt.Sprintf("%s%s", c.BaseURL, endpoint), nil)
+	req, err := http.NewRequest(method, fmt.Sprintf("%s%s", c.BaseURL, endpoint), reqBody)
 	if err != nil {
-		return "", err
+		return nil, nil, err
 	}
 
 	// Add headers
 	req = req.WithContext(ctx)
 	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.token))
 	req.Header.Set("Content-Type", "application/json")
+	for key, values := range header {
+		req.Header[key] = values
+	}
 
-	// Self-rate limit; the channel will block if we request too fast
-	c.rateLimiter <- 1
-	defer func() {
-		go func() {
-			time.Sleep(c.rateLimitResetInterval)
-			<-c.rateLimiter
-		}()
-	}()
-
-	// Make the reqest
-	resp, err := c.HTTPClient.Do(req)
+	// Make the reqest through any middleware
+	resp, err := c.doer().Do(req)
+	return req, resp, err
+}
 
-	if err != nil {
-		return "", err
-	}
+// decodeResponse decodes the response to req into a Response whose Data is v, and closes its body
+func (c *Client) decodeResponse(req *http.Request, resp *http.Response, v interface{}) (*Response, error) {
 
 	defer resp.Body.Close()
 
 	// Bail out non-2xx responses (http.Client.Do follows redirects)
 	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusBadRequest {
-		return "", fmt.Errorf("Non-2xx response: %s", resp.Status)
+		return nil, newAPIError(req, resp)
+	}
+
+	// Successful deletes return no content, so there is nothing to decode
+	if resp.StatusCode == http.StatusNoContent {
+		return &Response{Data: v}, nil
 	}
 
 	// Bail out for non-JSON responses
 	respContentHeader := resp.Header.Get("Content-Type")
 	if !strings.Contains(strings.ToLower(respContentHeader), "application/json") {
-		return "", fmt.Errorf("Non-JSON response: %s, Content-Type: %s", resp.Status, respContentHeader)
+		return nil, fmt.Errorf("Non-JSON response: %s, Content-Type: %s", resp.Status, respContentHeader)
 	}
 
 	// Attempt to decode the response into a Response object (Data takes the shape of v)
@@ -188,15 +337,19 @@ func (c *Client) makeRequest(ctx context.Context, method string, endpoint string
 	}
 
 	// Return err on failed JSON decode
-	if err = json.NewDecoder(resp.Body).Decode(&fullResponse); err != nil {
-		return "", err
+	if err := json.NewDecoder(resp.Body).Decode(&fullResponse); err != nil {
+		return nil, err
 	}
 
-	// If there is another page, return the URL to it
-	if fullResponse.Meta.CurrentPage < fullResponse.Meta.LastPage {
-		return fullResponse.Links.Next, nil
+	return &fullResponse, nil
+}
+
+// nextPage returns the URL of the next page of a paginated response, or "" if this is the last page
+func (r *Response) nextPage() string {
+
+	if r.Meta.CurrentPage < r.Meta.LastPage {
+		return r.Links.Next
 	}
 
-	// Otherwise return no next page, no error
-	return "", nil
+	return ""
 }
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"path/filepath"
+	"sync"
 	"testing"
 	"time"
 
@@ -81,6 +82,48 @@ func TestRequestResponses(t *testing.T) {
 	assert.EqualError(t, err, "Non-JSON response: 200 OK, Content-Type: text/html")
 }
 
+func TestRequestBodies(t *testing.T) {
+
+	testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+
+		// Deletes have no content
+		if req.Method == "DELETE" {
+			res.WriteHeader(204)
+			return
+		}
+
+		// Everything else echoes the request body
+		body, err := ioutil.ReadAll(req.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, "application/json", req.Header.Get("Content-Type"))
+
+		res.Header().Set("Content-Type", "application/json")
+		res.WriteHeader(200)
+		_, err = res.Write([]byte(fmt.Sprintf(`{"data": %s}`, body)))
+		assert.NoError(t, err)
+	}))
+	defer func() { testServer.Close() }()
+
+	// Create client
+	config := DefaultConfig()
+	config.BaseURL = testServer.URL
+	config.ForceTLS = false
+
+	client := NewClient(config)
+	ctx := context.Background()
+
+	// Test that request bodies are encoded and responses decoded
+	resp := map[string]string{}
+	_, err := client.makeRequestWithBody(ctx, "POST", "/", map[string]string{"name": "Waterdeep"}, &resp)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "Waterdeep", resp["name"])
+	}
+
+	// Test that 204 responses are not decoded
+	_, err = client.makeRequest(ctx, "DELETE", "/", nil)
+	assert.NoError(t, err)
+}
+
 func TestSelfRateLimit(t *testing.T) {
 
 	testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
@@ -125,19 +168,89 @@ func TestSelfRateLimit(t *testing.T) {
 	assert.True(t, end.After(start.Add(2000*time.Millisecond)))
 }
 
+// recordedRequest is a request received by a test server
+type recordedRequest struct {
+	Method string
+	Path   string
+	Body   string
+}
+
+// requestLog records the requests received by a test server, in the order they were received
+type requestLog struct {
+	mu       sync.Mutex
+	requests []recordedRequest
+}
+
+// record adds a request to the log
+func (l *requestLog) record(req recordedRequest) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.requests = append(l.requests, req)
+}
+
+// all returns every request received since the log was last reset
+func (l *requestLog) all() []recordedRequest {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return append([]recordedRequest{}, l.requests...)
+}
+
+// last returns the most recent request, or an empty request if there are none
+func (l *requestLog) last() recordedRequest {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if len(l.requests) == 0 {
+		return recordedRequest{}
+	}
+	return l.requests[len(l.requests)-1]
+}
+
+// reset forgets every request received so far
+func (l *requestLog) reset() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.requests = nil
+}
+
 // mockTestServer is a convenience function to return an httptest server
 // that loads a generic mock HTTP 200 JSON response.
+// Write requests without a mock file echo their request body back as the response data,
+// and delete requests without a mock file return HTTP 204.
 func mockTestServer() (*httptest.Server, *Config) {
+	testServer, config, _ := recordingTestServer()
+	return testServer, config
+}
+
+// recordingTestServer returns the same server as mockTestServer, along with a log of the requests it receives.
+// Since write requests are echoed back, tests of writes should check the log rather than the response.
+func recordingTestServer() (*httptest.Server, *Config, *requestLog) {
+
+	log := &requestLog{}
 
 	// Create the server
 	testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
 
+		reqBody, readErr := ioutil.ReadAll(req.Body)
+		log.record(recordedRequest{Method: req.Method, Path: req.URL.Path, Body: string(reqBody)})
+
 		// Mock file will be at a filepath that matches the URL path
 		mockFile := fmt.Sprintf("mocks/%s/%s.json", req.Method, req.URL.Path)
 		body, err := ioutil.ReadFile(filepath.Clean(mockFile))
 		if err != nil {
-			res.WriteHeader(404)
-			return
+			switch req.Method {
+			case "POST", "PUT", "PATCH":
+				if readErr != nil || len(reqBody) == 0 {
+					res.WriteHeader(400)
+					return
+				}
+				body = []byte(fmt.Sprintf(`{"data": %s}`, reqBody))
+			case "DELETE":
+				res.WriteHeader(204)
+				return
+			default:
+				res.WriteHeader(404)
+				return
+			}
 		}
 
 		res.Header().Set("Content-Type", "application/json")
@@ -154,5 +267,5 @@ func mockTestServer() (*httptest.Server, *Config) {
 	config.BaseURL = testServer.URL
 	config.ForceTLS = false
 
-	return testServer, config
+	return testServer, config, log
 }
@@ -0,0 +1,125 @@
+package kanka
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+var knownEntityTypes map[string]entityFetcher = make(map[string]entityFetcher)
+
+// entityFetcher fetches the object of one entity type by its ID (not its entity ID)
+type entityFetcher func(ctx context.Context, client *Client, campaignID int, id int) (Entity, error)
+
+// Entity is implemented by every type of entity, e.g. *Character and *Location, so that they can be
+// treated uniformly by generic tooling such as exports and search indexes
+type Entity interface {
+	// GetID returns the ID of the object within its entity type
+	GetID() int
+	// GetEntityID returns the ID of the object among all entities of the campaign
+	GetEntityID() int
+	// GetName returns the name of the object
+	GetName() string
+	// EntityType returns the entity type of the object, e.g. "character"
+	EntityType() string
+
+	// GetEntry returns the object's description, as HTML
+	GetEntry() string
+	// GetType returns the free-text type of the object, e.g. "Tavern" for a location
+	GetType() string
+	// GetIsPrivate returns true if the object is only visible to the campaign's admins
+	GetIsPrivate() bool
+	// GetImage and GetImageThumb return the URLs of the object's image and its thumbnail
+	GetImage() string
+	GetImageThumb() string
+
+	// GetCreatedAt, GetCreatedBy, GetUpdatedAt and GetUpdatedBy return when and by which user
+	// the object was created and last updated
+	GetCreatedAt() time.Time
+	GetCreatedBy() int
+	GetUpdatedAt() time.Time
+	GetUpdatedBy() int
+}
+
+// Entities is used to query the entities endpoints, which cover every type of entity in a campaign
+type Entities struct {
+	client     *Client
+	campaignID int
+	urlPrefix  string
+}
+
+// EntityInfo is used to serialize the generic entity object shared by every type of entity
+type EntityInfo struct {
+	ID         int       `json:"id"`
+	Name       string    `json:"name"`
+	Type       string    `json:"type"`
+	ChildID    int       `json:"child_id"`
+	Tags       []int     `json:"tags"`
+	IsPrivate  bool      `json:"is_private"`
+	CampaignID int       `json:"campaign_id"`
+	CreatedAt  time.Time `json:"created_at"`
+	CreatedBy  int       `json:"created_by"`
+	UpdatedAt  time.Time `json:"updated_at"`
+	UpdatedBy  int       `json:"updated_by"`
+}
+
+// Entities returns a handle on the entities endpoints
+func (c *Client) Entities(campaignID int) *Entities {
+	return &Entities{
+		client:     c,
+		campaignID: campaignID,
+		urlPrefix:  fmt.Sprintf("/campaigns/%d/entities", campaignID),
+	}
+}
+
+// GetEntities can return information about all entities, of every type
+func (e *Entities) GetEntities(ctx context.Context) (*[]EntityInfo, error) {
+	return getAll[EntityInfo](ctx, e.client, e.urlPrefix)
+}
+
+// List returns a pager which fetches entities of every type one page at a time
+func (e *Entities) List(opts *ListOptions) *Pager[EntityInfo] {
+	return newTypedPager[EntityInfo](e.client, e.urlPrefix, opts)
+}
+
+// GetEntity can return the generic information about a single entity
+func (e *Entities) GetEntity(ctx context.Context, entityID int) (*EntityInfo, error) {
+
+	resp := EntityInfo{}
+	_, err := e.client.makeRequest(ctx, "GET", fmt.Sprintf("%s/%d", e.urlPrefix, entityID), &resp)
+	return &resp, err
+}
+
+// Resolve looks up the type of an entity and fetches its object, e.g. a *Character for a character entity.
+// The entity type is available through the object's EntityType method.
+func (e *Entities) Resolve(ctx context.Context, entityID int) (Entity, error) {
+
+	info, err := e.GetEntity(ctx, entityID)
+	if err != nil {
+		return nil, err
+	}
+
+	return e.ResolveInfo(ctx, info)
+}
+
+// ResolveInfo fetches the object of an entity which has already been looked up, e.g. by GetEntities
+func (e *Entities) ResolveInfo(ctx context.Context, info *EntityInfo) (Entity, error) {
+
+	fetch, ok := knownEntityTypes[info.Type]
+	if !ok {
+		return nil, fmt.Errorf("entity %d has unknown entity type %q", info.ID, info.Type)
+	}
+
+	entity, err := fetch(ctx, e.client, e.campaignID, info.ChildID)
+	if err != nil {
+		return nil, err
+	}
+
+	return entity, nil
+}
+
+// addKnownEntityType is used to register entity types which can be resolved and the function to fetch them.
+// It should be called by all entity source files (e.g. character.go) in their init function
+func addKnownEntityType(entityType string, fn entityFetcher) {
+	knownEntityTypes[entityType] = fn
+}
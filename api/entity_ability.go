@@ -0,0 +1,193 @@
+package kanka
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// EntityAbilities is used to query the abilities assigned to an entity, e.g. a character's class features
+type EntityAbilities struct {
+	*EntityService[EntityAbility, EntityAbilityRequest]
+}
+
+// EntityAbility is used to serialize an entity ability object, which assigns an ability to an entity
+type EntityAbility struct {
+	ID        int  `json:"id"`
+	EntityID  int  `json:"entity_id"`
+	AbilityID int  `json:"ability_id"`
+	IsPrivate bool `json:"is_private"`
+
+	Charges  int    `json:"charges"`
+	Note     string `json:"note"`
+	Position int    `json:"position"`
+
+	Visibility   string `json:"visibility"`
+	VisibilityID int    `json:"visibility_id"`
+
+	CreatedAt time.Time `json:"created_at"`
+	CreatedBy int       `json:"created_by"`
+	UpdatedAt time.Time `json:"updated_at"`
+	UpdatedBy int       `json:"updated_by"`
+}
+
+// EntityAbilityRequest is used to create or update an entity ability
+type EntityAbilityRequest struct {
+	AbilityID int     `json:"ability_id"`
+	Charges   *int    `json:"charges,omitempty"`
+	Note      *string `json:"note,omitempty"`
+	Position  *int    `json:"position,omitempty"`
+
+	Visibility   *string `json:"visibility,omitempty"`
+	VisibilityID *int    `json:"visibility_id,omitempty"`
+
+	IsPrivate *bool `json:"is_private,omitempty"`
+}
+
+// AbilityTree is the hierarchy of a campaign's abilities, built from each ability's parent AbilityID
+type AbilityTree struct {
+	tree tree[int, AbilityNode, *AbilityNode]
+}
+
+// AbilityNode is a single ability in an AbilityTree
+type AbilityNode struct {
+	Ability Ability
+	branch  treeLinks[AbilityNode]
+
+	// Assignment is set if the ability is assigned to the entity the tree was marked for
+	Assignment *EntityAbility
+}
+
+// EntityAbilities returns a handle on the abilities assigned to an entity
+func (c *Client) EntityAbilities(campaignID int, entityID int) *EntityAbilities {
+	return &EntityAbilities{
+		EntityService: newEntityService[EntityAbility, EntityAbilityRequest](c, campaignID, fmt.Sprintf("entities/%d/entity_abilities", entityID)),
+	}
+}
+
+// GetEntityAbilities can return information about all abilities assigned to the entity
+func (e *EntityAbilities) GetEntityAbilities(ctx context.Context) (*[]EntityAbility, error) {
+	return e.GetAll(ctx)
+}
+
+// GetEntityAbility can return information about a single assigned ability
+func (e *EntityAbilities) GetEntityAbility(ctx context.Context, id int) (*EntityAbility, error) {
+	return e.Get(ctx, id)
+}
+
+// CreateEntityAbility can assign an ability to the entity and return the result
+func (e *EntityAbilities) CreateEntityAbility(ctx context.Context, ability *EntityAbilityRequest) (*EntityAbility, error) {
+	return e.Create(ctx, ability)
+}
+
+// UpdateEntityAbility can update an assigned ability and return the result
+func (e *EntityAbilities) UpdateEntityAbility(ctx context.Context, id int, ability *EntityAbilityRequest) (*EntityAbility, error) {
+	return e.Update(ctx, id, ability)
+}
+
+// PatchEntityAbility can update only the given fields of an assigned ability and return the result
+func (e *EntityAbilities) PatchEntityAbility(ctx context.Context, id int, fields map[string]interface{}) (*EntityAbility, error) {
+	return e.Patch(ctx, id, fields)
+}
+
+// DeleteEntityAbility can remove an ability from the entity
+func (e *EntityAbilities) DeleteEntityAbility(ctx context.Context, id int) error {
+	return e.Delete(ctx, id)
+}
+
+// BuildAbilityTree fetches every ability of a campaign into a tree.
+// If entityID is not zero, the abilities assigned to that entity are marked in the tree.
+func (c *Client) BuildAbilityTree(ctx context.Context, campaignID int, entityID int) (*AbilityTree, error) {
+
+	abilities, err := c.Abilities(campaignID).GetAbilities(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tree := NewAbilityTree(*abilities)
+	if entityID == 0 {
+		return tree, nil
+	}
+
+	assigned, err := c.EntityAbilities(campaignID, entityID).GetEntityAbilities(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tree.Mark(*assigned)
+
+	return tree, nil
+}
+
+// NewAbilityTree returns the tree of the given abilities.
+// Abilities whose parent is missing, or whose parents form a loop, become roots.
+func NewAbilityTree(abilities []Ability) *AbilityTree {
+
+	nodes := make([]*AbilityNode, 0, len(abilities))
+	for _, ability := range abilities {
+		nodes = append(nodes, &AbilityNode{Ability: ability})
+	}
+
+	return &AbilityTree{tree: newTree[int, AbilityNode](nodes)}
+}
+
+// Roots returns the abilities without a parent, sorted by name
+func (t *AbilityTree) Roots() []*AbilityNode {
+	return t.tree.rootNodes()
+}
+
+// Node returns the node of the ability with the given ID
+func (t *AbilityTree) Node(abilityID int) (*AbilityNode, bool) {
+	return t.tree.node(abilityID)
+}
+
+// Walk calls fn for every node of the tree, depth-first, parents before their children
+func (t *AbilityTree) Walk(fn func(node *AbilityNode, depth int)) {
+	t.tree.walk(fn)
+}
+
+// Parent returns the node of the ability's parent, or nil if it is a root
+func (n *AbilityNode) Parent() *AbilityNode {
+	return n.branch.parent
+}
+
+// Children returns the nodes of the ability's children, sorted by name
+func (n *AbilityNode) Children() []*AbilityNode {
+	return n.branch.childNodes()
+}
+
+// Mark marks the abilities assigned to an entity, clearing any previous marks
+func (t *AbilityTree) Mark(assigned []EntityAbility) {
+
+	for _, node := range t.tree.nodes {
+		node.Assignment = nil
+	}
+
+	for i := range assigned {
+		if node, ok := t.tree.nodes[assigned[i].AbilityID]; ok {
+			node.Assignment = &assigned[i]
+		}
+	}
+}
+
+// Assigned returns true if the ability is assigned to the entity the tree was marked for
+func (n *AbilityNode) Assigned() bool {
+	return n.Assignment != nil
+}
+
+// keys implements treeNode
+func (n *AbilityNode) keys() (int, int) {
+	return n.Ability.ID, n.Ability.AbilityID
+}
+
+// links implements treeNode
+func (n *AbilityNode) links() *treeLinks[AbilityNode] {
+	return &n.branch
+}
+
+// less sorts abilities by name, then by ID
+func (n *AbilityNode) less(other *AbilityNode) bool {
+	if n.Ability.Name != other.Ability.Name {
+		return n.Ability.Name < other.Ability.Name
+	}
+	return n.Ability.ID < other.Ability.ID
+}
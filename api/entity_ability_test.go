@@ -0,0 +1,141 @@
+package kanka
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEntityAbilities(t *testing.T) {
+
+	client := NewClient(DefaultConfig())
+	e := client.EntityAbilities(1, 4)
+
+	assert.Equal(t, client, e.client)
+	assert.Equal(t, "/campaigns/1/entities/4/entity_abilities", e.urlPrefix)
+}
+
+func TestGetEntityAbilities(t *testing.T) {
+
+	testServer, config := mockTestServer()
+	defer testServer.Close()
+	client := NewClient(config)
+	ctx := context.Background()
+
+	abilities, err := client.EntityAbilities(1, 4).GetEntityAbilities(ctx)
+
+	if assert.NoError(t, err) {
+		assert.Len(t, *abilities, 1)
+
+		a := (*abilities)[0]
+		assert.Equal(t, 1, a.ID)
+		assert.Equal(t, 4, a.EntityID)
+		assert.Equal(t, 1, a.AbilityID)
+		assert.Equal(t, 3, a.Charges)
+		assert.Equal(t, "Once per day", a.Note)
+		assert.Equal(t, "all", a.Visibility)
+	}
+}
+
+func TestGetEntityAbility(t *testing.T) {
+
+	testServer, config := mockTestServer()
+	defer testServer.Close()
+	client := NewClient(config)
+	ctx := context.Background()
+
+	ability, err := client.EntityAbilities(1, 4).GetEntityAbility(ctx, 1)
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, 1, ability.ID)
+		assert.Equal(t, 1, ability.AbilityID)
+	}
+}
+
+func TestWriteEntityAbilities(t *testing.T) {
+
+	testServer, config, requests := recordingTestServer()
+	defer testServer.Close()
+	client := NewClient(config)
+	ctx := context.Background()
+	abilities := client.EntityAbilities(1, 4)
+
+	_, err := abilities.CreateEntityAbility(ctx, &EntityAbilityRequest{AbilityID: 2, Charges: Int(1), Visibility: String(VisibilityAdmin)})
+	assert.NoError(t, err)
+	assert.Equal(t, recordedRequest{Method: "POST", Path: "/campaigns/1/entities/4/entity_abilities", Body: `{"ability_id":2,"charges":1,"visibility":"admin"}`}, requests.last())
+
+	_, err = abilities.UpdateEntityAbility(ctx, 1, &EntityAbilityRequest{AbilityID: 1, Charges: Int(0)})
+	assert.NoError(t, err)
+	assert.Equal(t, recordedRequest{Method: "PUT", Path: "/campaigns/1/entities/4/entity_abilities/1", Body: `{"ability_id":1,"charges":0}`}, requests.last())
+
+	_, err = abilities.PatchEntityAbility(ctx, 1, map[string]interface{}{"charges": 0})
+	assert.NoError(t, err)
+	assert.Equal(t, recordedRequest{Method: "PATCH", Path: "/campaigns/1/entities/4/entity_abilities/1", Body: `{"charges":0}`}, requests.last())
+
+	assert.NoError(t, abilities.DeleteEntityAbility(ctx, 1))
+	assert.Equal(t, recordedRequest{Method: "DELETE", Path: "/campaigns/1/entities/4/entity_abilities/1"}, requests.last())
+}
+
+func TestAbilityTree(t *testing.T) {
+
+	tree := NewAbilityTree([]Ability{
+		{ID: 1, Name: "Wizard"},
+		{ID: 2, Name: "Evocation", AbilityID: 1},
+		{ID: 3, Name: "Fireball", AbilityID: 2},
+		{ID: 4, Name: "Abjuration", AbilityID: 1},
+	})
+
+	tree.Mark([]EntityAbility{{ID: 10, AbilityID: 3, Charges: 2}, {ID: 11, AbilityID: 99}})
+
+	// Render the tree, marking assigned abilities
+	lines := []string{}
+	tree.Walk(func(node *AbilityNode, depth int) {
+		line := strings.Repeat("  ", depth) + node.Ability.Name
+		if node.Assigned() {
+			line += " *"
+		}
+		lines = append(lines, line)
+	})
+
+	assert.Equal(t, []string{
+		"Wizard",
+		"  Abjuration",
+		"  Evocation",
+		"    Fireball *",
+	}, lines)
+
+	node, ok := tree.Node(3)
+	if assert.True(t, ok) {
+		assert.Equal(t, "Evocation", node.Parent().Ability.Name)
+		assert.Equal(t, 2, node.Assignment.Charges)
+	}
+
+	_, ok = tree.Node(99)
+	assert.False(t, ok)
+
+	// Marking again clears the previous marks
+	tree.Mark(nil)
+	assert.False(t, node.Assigned())
+}
+
+func TestBuildAbilityTree(t *testing.T) {
+
+	testServer, config := mockTestServer()
+	defer testServer.Close()
+	client := NewClient(config)
+	ctx := context.Background()
+
+	tree, err := client.BuildAbilityTree(ctx, 1, 4)
+
+	if assert.NoError(t, err) && assert.Len(t, tree.Roots(), 1) {
+		assert.Equal(t, "Fireball", tree.Roots()[0].Ability.Name)
+		assert.True(t, tree.Roots()[0].Assigned())
+	}
+
+	tree, err = client.BuildAbilityTree(ctx, 1, 0)
+	if assert.NoError(t, err) && assert.Len(t, tree.Roots(), 1) {
+		assert.False(t, tree.Roots()[0].Assigned())
+	}
+}
@@ -0,0 +1,98 @@
+package kanka
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// EntityEvents is used to query the entity events endpoints of an entity.
+// Entity events are reminders anchored to a date of a calendar, e.g. birthdays or recurring festivals.
+type EntityEvents struct {
+	*EntityService[EntityEvent, EntityEventRequest]
+}
+
+// EntityEvent is used to serialize an entity event (reminder) object
+type EntityEvent struct {
+	ID         int `json:"id"`
+	EntityID   int `json:"entity_id"`
+	CalendarID int `json:"calendar_id"`
+
+	Date    string `json:"date"`
+	Day     int    `json:"day"`
+	Month   int    `json:"month"`
+	Year    int    `json:"year"`
+	Length  int    `json:"length"`
+	Comment string `json:"comment"`
+	Colour  string `json:"colour"`
+	TypeID  int    `json:"type_id"`
+
+	IsRecurring          bool   `json:"is_recurring"`
+	RecurringUntil       int    `json:"recurring_until"`
+	RecurringPeriodicity string `json:"recurring_periodicity"`
+
+	Visibility string `json:"visibility"`
+
+	CreatedAt time.Time `json:"created_at"`
+	CreatedBy int       `json:"created_by"`
+	UpdatedAt time.Time `json:"updated_at"`
+	UpdatedBy int       `json:"updated_by"`
+}
+
+// EntityEventRequest is used to create or update an entity event
+type EntityEventRequest struct {
+	CalendarID int     `json:"calendar_id"`
+	Day        int     `json:"day"`
+	Month      int     `json:"month"`
+	Year       int     `json:"year"`
+	Length     *int    `json:"length,omitempty"`
+	Comment    *string `json:"comment,omitempty"`
+	Colour     *string `json:"colour,omitempty"`
+	TypeID     *int    `json:"type_id,omitempty"`
+
+	IsRecurring          *bool   `json:"is_recurring,omitempty"`
+	RecurringUntil       *int    `json:"recurring_until,omitempty"`
+	RecurringPeriodicity *string `json:"recurring_periodicity,omitempty"`
+
+	Visibility *string `json:"visibility,omitempty"`
+}
+
+// EntityEventPager is used to iterate over pages of entity events
+type EntityEventPager = Pager[EntityEvent]
+
+// EntityEvents returns a handle on the entity events endpoints of an entity
+func (c *Client) EntityEvents(campaignID int, entityID int) *EntityEvents {
+	return &EntityEvents{
+		EntityService: newEntityService[EntityEvent, EntityEventRequest](c, campaignID, fmt.Sprintf("entities/%d/entity_events", entityID)),
+	}
+}
+
+// GetEntityEvents can return information about all events of the entity
+func (e *EntityEvents) GetEntityEvents(ctx context.Context) (*[]EntityEvent, error) {
+	return e.GetAll(ctx)
+}
+
+// GetEntityEvent can return information about a single event
+func (e *EntityEvents) GetEntityEvent(ctx context.Context, id int) (*EntityEvent, error) {
+	return e.Get(ctx, id)
+}
+
+// CreateEntityEvent can create a new event and return the result
+func (e *EntityEvents) CreateEntityEvent(ctx context.Context, event *EntityEventRequest) (*EntityEvent, error) {
+	return e.Create(ctx, event)
+}
+
+// UpdateEntityEvent can update an event and return the result
+func (e *EntityEvents) UpdateEntityEvent(ctx context.Context, id int, event *EntityEventRequest) (*EntityEvent, error) {
+	return e.Update(ctx, id, event)
+}
+
+// PatchEntityEvent can update only the given fields of an event and return the result
+func (e *EntityEvents) PatchEntityEvent(ctx context.Context, id int, fields map[string]interface{}) (*EntityEvent, error) {
+	return e.Patch(ctx, id, fields)
+}
+
+// DeleteEntityEvent can delete an event
+func (e *EntityEvents) DeleteEntityEvent(ctx context.Context, id int) error {
+	return e.Delete(ctx, id)
+}
@@ -0,0 +1,85 @@
+package kanka
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEntityEvents(t *testing.T) {
+
+	client := NewClient(DefaultConfig())
+	e := client.EntityEvents(1, 4)
+
+	assert.Equal(t, client, e.client)
+	assert.Equal(t, "/campaigns/1/entities/4/entity_events", e.urlPrefix)
+}
+
+func TestGetEntityEvents(t *testing.T) {
+
+	testServer, config := mockTestServer()
+	defer testServer.Close()
+	client := NewClient(config)
+	ctx := context.Background()
+
+	events, err := client.EntityEvents(1, 4).GetEntityEvents(ctx)
+
+	if assert.NoError(t, err) {
+		assert.Len(t, *events, 1)
+
+		e := (*events)[0]
+		assert.Equal(t, 1, e.ID)
+		assert.Equal(t, 4, e.EntityID)
+		assert.Equal(t, 1, e.CalendarID)
+		assert.Equal(t, "1390-3-12", e.Date)
+		assert.Equal(t, 12, e.Day)
+		assert.Equal(t, 3, e.Month)
+		assert.Equal(t, 1390, e.Year)
+		assert.Equal(t, 1, e.Length)
+		assert.Equal(t, "Birthday", e.Comment)
+		assert.Equal(t, "green", e.Colour)
+		assert.Equal(t, true, e.IsRecurring)
+		assert.Equal(t, 1500, e.RecurringUntil)
+		assert.Equal(t, "year", e.RecurringPeriodicity)
+	}
+}
+
+func TestGetEntityEvent(t *testing.T) {
+
+	testServer, config := mockTestServer()
+	defer testServer.Close()
+	client := NewClient(config)
+	ctx := context.Background()
+
+	event, err := client.EntityEvents(1, 4).GetEntityEvent(ctx, 1)
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, 1, event.ID)
+		assert.Equal(t, "Birthday", event.Comment)
+	}
+}
+
+func TestWriteEntityEvents(t *testing.T) {
+
+	testServer, config, requests := recordingTestServer()
+	defer testServer.Close()
+	client := NewClient(config)
+	ctx := context.Background()
+	events := client.EntityEvents(1, 4)
+
+	_, err := events.CreateEntityEvent(ctx, &EntityEventRequest{CalendarID: 1, Day: 1, Month: 6, Year: 1400, Comment: String("Death")})
+	assert.NoError(t, err)
+	assert.Equal(t, recordedRequest{Method: "POST", Path: "/campaigns/1/entities/4/entity_events", Body: `{"calendar_id":1,"day":1,"month":6,"year":1400,"comment":"Death"}`}, requests.last())
+
+	_, err = events.UpdateEntityEvent(ctx, 1, &EntityEventRequest{CalendarID: 1, Day: 13, Month: 3, Year: 1390, IsRecurring: Bool(false)})
+	assert.NoError(t, err)
+	assert.Equal(t, recordedRequest{Method: "PUT", Path: "/campaigns/1/entities/4/entity_events/1", Body: `{"calendar_id":1,"day":13,"month":3,"year":1390,"is_recurring":false}`}, requests.last())
+
+	_, err = events.PatchEntityEvent(ctx, 1, map[string]interface{}{"is_recurring": false})
+	assert.NoError(t, err)
+	assert.Equal(t, recordedRequest{Method: "PATCH", Path: "/campaigns/1/entities/4/entity_events/1", Body: `{"is_recurring":false}`}, requests.last())
+
+	assert.NoError(t, events.DeleteEntityEvent(ctx, 1))
+	assert.Equal(t, recordedRequest{Method: "DELETE", Path: "/campaigns/1/entities/4/entity_events/1"}, requests.last())
+}
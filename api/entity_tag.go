@@ -0,0 +1,52 @@
+package kanka
+
+import (
+	"context"
+	"fmt"
+)
+
+// EntityTag is used to serialize an entity tag object, which tags an entity with a tag
+type EntityTag struct {
+	ID       int `json:"id"`
+	EntityID int `json:"entity_id"`
+	TagID    int `json:"tag_id"`
+}
+
+// EntityTagRequest is used to create or update an entity tag
+type EntityTagRequest struct {
+	EntityID int `json:"entity_id"`
+	TagID    int `json:"tag_id"`
+}
+
+// GetEntityTags can return information about all tags of a given entity
+func (e *Entities) GetEntityTags(ctx context.Context, entityID int) (*[]EntityTag, error) {
+	return entityTags(e, entityID).GetAll(ctx)
+}
+
+// AddTag can tag a given entity with a tag and return the result
+func (e *Entities) AddTag(ctx context.Context, entityID int, tagID int) (*EntityTag, error) {
+	return entityTags(e, entityID).Create(ctx, &EntityTagRequest{EntityID: entityID, TagID: tagID})
+}
+
+// RemoveTag can remove a tag from a given entity. Removing a tag the entity doesn't have does nothing.
+func (e *Entities) RemoveTag(ctx context.Context, entityID int, tagID int) error {
+
+	// Entity tags are deleted by their own ID rather than the tag's, so look it up first
+	tags, err := e.GetEntityTags(ctx, entityID)
+	if err != nil {
+		return err
+	}
+
+	for _, tag := range *tags {
+		if tag.TagID == tagID {
+			return entityTags(e, entityID).Delete(ctx, tag.ID)
+		}
+	}
+
+	return nil
+}
+
+// entityTags returns a service for the tags of a given entity
+func entityTags(e *Entities, entityID int) *EntityService[EntityTag, EntityTagRequest] {
+	return newEntityService[EntityTag, EntityTagRequest](e.client, e.campaignID, fmt.Sprintf("entities/%d/tags", entityID))
+}
@@ -0,0 +1,51 @@
+package kanka
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetEntityTags(t *testing.T) {
+
+	testServer, config := mockTestServer()
+	defer testServer.Close()
+	client := NewClient(config)
+	ctx := context.Background()
+
+	tags, err := client.Entities(1).GetEntityTags(ctx, 4)
+
+	if assert.NoError(t, err) && assert.Len(t, *tags, 2) {
+		assert.Equal(t, EntityTag{ID: 7, EntityID: 4, TagID: 1}, (*tags)[0])
+	}
+}
+
+func TestWriteEntityTags(t *testing.T) {
+
+	testServer, config, requests := recordingTestServer()
+	defer testServer.Close()
+	client := NewClient(config)
+	ctx := context.Background()
+	entities := client.Entities(1)
+
+	tag, err := entities.AddTag(ctx, 4, 3)
+	if assert.NoError(t, err) {
+		assert.Equal(t, 4, tag.EntityID)
+		assert.Equal(t, 3, tag.TagID)
+	}
+	assert.Equal(t, recordedRequest{Method: "POST", Path: "/campaigns/1/entities/4/tags", Body: `{"entity_id":4,"tag_id":3}`}, requests.last())
+
+	// Entity tags are deleted by their own ID
+	requests.reset()
+	assert.NoError(t, entities.RemoveTag(ctx, 4, 2))
+	assert.Equal(t, []recordedRequest{
+		{Method: "GET", Path: "/campaigns/1/entities/4/tags"},
+		{Method: "DELETE", Path: "/campaigns/1/entities/4/tags/8"},
+	}, requests.all())
+
+	// Removing a tag the entity doesn't have does nothing
+	requests.reset()
+	assert.NoError(t, entities.RemoveTag(ctx, 4, 99))
+	assert.Equal(t, []recordedRequest{{Method: "GET", Path: "/campaigns/1/entities/4/tags"}}, requests.all())
+}
@@ -0,0 +1,128 @@
+package kanka
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEntities(t *testing.T) {
+
+	client := NewClient(DefaultConfig())
+	e := client.Entities(1)
+
+	assert.Equal(t, client, e.client)
+	assert.Equal(t, "/campaigns/1/entities", e.urlPrefix)
+}
+
+func TestGetEntities(t *testing.T) {
+
+	testServer, config := mockTestServer()
+	defer testServer.Close()
+	client := NewClient(config)
+	ctx := context.Background()
+
+	entities, err := client.Entities(1).GetEntities(ctx)
+
+	if assert.NoError(t, err) {
+		assert.Len(t, *entities, 1)
+		assert.Equal(t, 4, (*entities)[0].ID)
+		assert.Equal(t, "character", (*entities)[0].Type)
+		assert.Equal(t, 1, (*entities)[0].ChildID)
+	}
+}
+
+func TestGetEntity(t *testing.T) {
+
+	testServer, config := mockTestServer()
+	defer testServer.Close()
+	client := NewClient(config)
+	ctx := context.Background()
+
+	entity, err := client.Entities(1).GetEntity(ctx, 4)
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, 4, entity.ID)
+		assert.Equal(t, "Jonathan Green", entity.Name)
+		assert.Equal(t, "character", entity.Type)
+		assert.Equal(t, 1, entity.ChildID)
+		assert.Equal(t, true, entity.IsPrivate)
+		assert.Equal(t, 1, entity.CampaignID)
+	}
+}
+
+func TestResolveEntity(t *testing.T) {
+
+	testServer, config := mockTestServer()
+	defer testServer.Close()
+	client := NewClient(config)
+	ctx := context.Background()
+
+	entity, err := client.Entities(1).Resolve(ctx, 4)
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, "character", entity.EntityType())
+		assert.Equal(t, 1, entity.GetID())
+		assert.Equal(t, 4, entity.GetEntityID())
+		assert.Equal(t, "Jonathan Green", entity.GetName())
+
+		character, ok := entity.(*Character)
+		if assert.True(t, ok) {
+			assert.Equal(t, "The Hero", character.Title)
+		}
+	}
+
+	// Unknown entity types can't be resolved
+	_, err = client.Entities(1).Resolve(ctx, 5)
+	assert.EqualError(t, err, `entity 5 has unknown entity type "dragon"`)
+
+	// Missing entities fail
+	_, err = client.Entities(1).Resolve(ctx, 404)
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestKnownEntityTypes(t *testing.T) {
+
+	for _, entityType := range []string{
+		"ability", "calendar", "character", "event", "family", "item", "journal", "location",
+		"map", "note", "organisation", "quest", "race", "tag", "timeline",
+	} {
+		assert.Contains(t, knownEntityTypes, entityType)
+	}
+
+	// Every entity type reports its own type
+	entities := []Entity{
+		&Ability{}, &Calendar{}, &Character{}, &Event{}, &Family{}, &Item{}, &Journal{}, &Location{},
+		&Map{}, &Note{}, &Organisation{}, &Quest{}, &Race{}, &Tag{}, &Timeline{},
+	}
+	for _, entity := range entities {
+		assert.Contains(t, knownEntityTypes, entity.EntityType())
+	}
+}
+
+func TestEntityAccessors(t *testing.T) {
+
+	testServer, config := mockTestServer()
+	defer testServer.Close()
+	client := NewClient(config)
+	ctx := context.Background()
+
+	character, err := client.Characters(1).GetCharacter(ctx, 1)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	// Generic code sees the same values as the typed struct
+	var entity Entity = character
+	assert.Equal(t, character.Entry, entity.GetEntry())
+	assert.Equal(t, character.Type, entity.GetType())
+	assert.Equal(t, true, entity.GetIsPrivate())
+	assert.Equal(t, "https://example.com/image.png", entity.GetImage())
+	assert.Equal(t, character.ImageThumb, entity.GetImageThumb())
+	assert.Equal(t, time.Date(2019, time.January, 29, 16, 40, 34, 0, time.UTC), entity.GetCreatedAt())
+	assert.Equal(t, 1, entity.GetCreatedBy())
+	assert.Equal(t, time.Date(2019, time.August, 29, 13, 38, 46, 0, time.UTC), entity.GetUpdatedAt())
+	assert.Equal(t, 1, entity.GetUpdatedBy())
+}
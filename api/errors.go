@@ -0,0 +1,134 @@
+package kanka
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxErrorBodySize caps how much of an error response body is read when decoding an APIError
+const maxErrorBodySize = 1 << 20
+
+var (
+	// ErrBadRequest matches APIErrors with HTTP 400 status
+	ErrBadRequest = errors.New("kanka: bad request")
+	// ErrUnauthorized matches APIErrors with HTTP 401 status, usually a missing or invalid token
+	ErrUnauthorized = errors.New("kanka: unauthorized")
+	// ErrForbidden matches APIErrors with HTTP 403 status
+	ErrForbidden = errors.New("kanka: forbidden")
+	// ErrNotFound matches APIErrors with HTTP 404 status
+	ErrNotFound = errors.New("kanka: not found")
+	// ErrValidation matches APIErrors with HTTP 422 status; see APIError.Errors for details
+	ErrValidation = errors.New("kanka: validation failed")
+	// ErrRateLimited matches APIErrors with HTTP 429 status; see APIError.RetryAfter for when to try again
+	ErrRateLimited = errors.New("kanka: rate limited")
+	// ErrServer matches APIErrors with HTTP 5xx status
+	ErrServer = errors.New("kanka: server error")
+)
+
+// APIError is returned when the Kanka API responds with a non-2xx status.
+// Use errors.As to retrieve it, or errors.Is with one of the Err* sentinels to branch on its status.
+type APIError struct {
+	StatusCode int
+	Status     string
+	Method     string
+	URL        string
+
+	// Message and Errors are decoded from the response body if Kanka returned JSON.
+	// Errors maps field names to validation messages for HTTP 422 responses.
+	Message string
+	Errors  map[string][]string
+
+	// RetryAfter is the delay requested by the Retry-After header, or zero if there was none
+	RetryAfter time.Duration
+}
+
+// Error implements the error interface
+func (e *APIError) Error() string {
+
+	msg := fmt.Sprintf("Non-2xx response: %s", e.Status)
+	if e.Message != "" {
+		msg = fmt.Sprintf("%s: %s", msg, e.Message)
+	}
+
+	return msg
+}
+
+// Is allows errors.Is to match an APIError against the Err* sentinels
+func (e *APIError) Is(target error) bool {
+
+	switch target {
+	case ErrBadRequest:
+		return e.StatusCode == http.StatusBadRequest
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrForbidden:
+		return e.StatusCode == http.StatusForbidden
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrValidation:
+		return e.StatusCode == http.StatusUnprocessableEntity
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	case ErrServer:
+		return e.StatusCode >= http.StatusInternalServerError
+	}
+
+	return false
+}
+
+// newAPIError builds an APIError from a non-2xx response, decoding the Kanka error payload if there is one
+func newAPIError(req *http.Request, resp *http.Response) *APIError {
+
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+		Status:     resp.Status,
+		Method:     req.Method,
+		URL:        req.URL.String(),
+		RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()),
+	}
+
+	// Kanka usually returns a JSON payload with a message and (for validation failures) field errors;
+	// a payload that fails to decode still leaves the status information intact
+	if strings.Contains(strings.ToLower(resp.Header.Get("Content-Type")), "application/json") {
+		payload := struct {
+			Message string              `json:"message"`
+			Errors  map[string][]string `json:"errors"`
+		}{}
+		body, err := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+		if err == nil && json.Unmarshal(body, &payload) == nil {
+			apiErr.Message = payload.Message
+			apiErr.Errors = payload.Errors
+		}
+	}
+
+	return apiErr
+}
+
+// parseRetryAfter converts a Retry-After header value (either delay-seconds or an HTTP date) into a duration.
+// Returns zero if the header is empty, malformed, or in the past.
+func parseRetryAfter(value string, now time.Time) time.Duration {
+
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+
+	if date, err := http.ParseTime(value); err == nil && date.After(now) {
+		return date.Sub(now)
+	}
+
+	return 0
+}
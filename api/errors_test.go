@@ -0,0 +1,98 @@
+package kanka
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIErrors(t *testing.T) {
+
+	testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+
+		switch path := req.URL.Path; path {
+		case "/401":
+			res.Header().Set("Content-Type", "application/json")
+			res.WriteHeader(401)
+			_, _ = res.Write([]byte(`{"message": "Unauthenticated."}`))
+		case "/422":
+			res.Header().Set("Content-Type", "application/json")
+			res.WriteHeader(422)
+			_, _ = res.Write([]byte(`{"message": "The given data was invalid.", "errors": {"name": ["The name field is required."]}}`))
+		case "/429":
+			res.Header().Set("Retry-After", "12")
+			res.WriteHeader(429)
+		case "/500":
+			res.Header().Set("Content-Type", "application/json")
+			res.WriteHeader(500)
+			_, _ = res.Write([]byte(`<html>not json</html>`))
+		default:
+			res.WriteHeader(404)
+		}
+	}))
+	defer func() { testServer.Close() }()
+
+	// Create client
+	config := DefaultConfig()
+	config.BaseURL = testServer.URL
+	config.ForceTLS = false
+	config.Retry = nil
+
+	client := NewClient(config)
+	ctx := context.Background()
+
+	// Test 401s with a message
+	_, err := client.makeRequest(ctx, "GET", "/401", nil)
+	assert.EqualError(t, err, "Non-2xx response: 401 Unauthorized: Unauthenticated.")
+	assert.True(t, errors.Is(err, ErrUnauthorized))
+	assert.False(t, errors.Is(err, ErrNotFound))
+
+	// Test 404s
+	_, err = client.makeRequest(ctx, "DELETE", "/404", nil)
+	assert.True(t, errors.Is(err, ErrNotFound))
+
+	var apiErr *APIError
+	if assert.True(t, errors.As(err, &apiErr)) {
+		assert.Equal(t, 404, apiErr.StatusCode)
+		assert.Equal(t, "DELETE", apiErr.Method)
+		assert.Equal(t, testServer.URL+"/404", apiErr.URL)
+		assert.Empty(t, apiErr.Message)
+	}
+
+	// Test 422s with validation messages
+	_, err = client.makeRequest(ctx, "POST", "/422", nil)
+	assert.True(t, errors.Is(err, ErrValidation))
+	if assert.True(t, errors.As(err, &apiErr)) {
+		assert.Equal(t, "The given data was invalid.", apiErr.Message)
+		assert.Equal(t, []string{"The name field is required."}, apiErr.Errors["name"])
+	}
+
+	// Test 429s with Retry-After
+	_, err = client.makeRequest(ctx, "GET", "/429", nil)
+	assert.True(t, errors.Is(err, ErrRateLimited))
+	if assert.True(t, errors.As(err, &apiErr)) {
+		assert.Equal(t, 12*time.Second, apiErr.RetryAfter)
+	}
+
+	// Test 5xx with a payload that fails to decode
+	_, err = client.makeRequest(ctx, "GET", "/500", nil)
+	assert.EqualError(t, err, "Non-2xx response: 500 Internal Server Error")
+	assert.True(t, errors.Is(err, ErrServer))
+}
+
+func TestParseRetryAfter(t *testing.T) {
+
+	now := time.Date(2020, time.July, 25, 10, 10, 30, 0, time.UTC)
+
+	assert.Equal(t, time.Duration(0), parseRetryAfter("", now))
+	assert.Equal(t, time.Duration(0), parseRetryAfter("soon", now))
+	assert.Equal(t, time.Duration(0), parseRetryAfter("-5", now))
+	assert.Equal(t, 30*time.Second, parseRetryAfter("30", now))
+	assert.Equal(t, 90*time.Second, parseRetryAfter("Sat, 25 Jul 2020 10:12:00 GMT", now))
+	assert.Equal(t, time.Duration(0), parseRetryAfter("Sat, 25 Jul 2020 10:00:00 GMT", now))
+}
@@ -2,15 +2,13 @@ package kanka
 
 import (
 	"context"
-	"fmt"
+	"net/url"
 	"time"
 )
 
 // Events is used to query the events endpoints
 type Events struct {
-	client     *Client
-	campaignID int
-	urlPrefix  string
+	*EntityService[Event, EventRequest]
 }
 
 // Event is used to serialize an event object
@@ -36,42 +34,151 @@ type Event struct {
 	UpdatedBy int       `json:"updated_by"`
 }
 
+// EventRequest is used to create or update an event
+type EventRequest struct {
+	Name       string  `json:"name"`
+	Entry      *string `json:"entry,omitempty"`
+	Date       *string `json:"date,omitempty"`
+	LocationID *int    `json:"location_id,omitempty"`
+	Type       *string `json:"type,omitempty"`
+
+	IsPrivate *bool `json:"is_private,omitempty"`
+}
+
+// EventFilter is used to filter events in ListOptions
+type EventFilter struct {
+	LocationID int
+}
+
+// Apply implements Filter
+func (f EventFilter) Apply(q url.Values) {
+	setInt(q, "location_id", f.LocationID)
+}
+
 func init() {
 	addKnownLinkType("event", func(ctx context.Context, client *Client, campaignID int, entityID int) string {
 		event, _ := client.Events(campaignID).GetEvent(ctx, entityID)
 		return event.Name
 	})
+	addKnownSyncType("event", func(ctx context.Context, client *Client, campaignID int, opts *ListOptions) ([]SyncRecord, error) {
+		records := []SyncRecord{}
+		err := client.Events(campaignID).Iterate(ctx, opts, func(event *Event) error {
+			records = append(records, newSyncRecord(event))
+			return nil
+		})
+		return records, err
+	})
+	addKnownEntityType("event", func(ctx context.Context, client *Client, campaignID int, id int) (Entity, error) {
+		return client.Events(campaignID).GetEvent(ctx, id)
+	})
 }
 
 // Events returns a handle of the events endpoint
 func (c *Client) Events(campaignID int) *Events {
 	return &Events{
-		client:     c,
-		campaignID: campaignID,
-		urlPrefix:  fmt.Sprintf("/campaigns/%d/events", campaignID),
+		EntityService: newEntityService[Event, EventRequest](c, campaignID, "events"),
 	}
 }
 
 // GetEvents can return information about all events
 func (e *Events) GetEvents(ctx context.Context) (*[]Event, error) {
+	return e.GetAll(ctx)
+}
+
+// GetEvent can return information about a single event
+func (e *Events) GetEvent(ctx context.Context, id int) (*Event, error) {
+	return e.Get(ctx, id)
+}
 
-	var err error
-	resp := []Event{}
-	url := e.urlPrefix
+// GetEventWithPosts can return information about a single event along with all of its posts
+func (e *Events) GetEventWithPosts(ctx context.Context, id int) (*Event, *[]EntityPost, error) {
+	return getWithPosts(ctx, e.EntityService, id)
+}
 
-	for len(url) > 0 && err == nil {
-		page := []Event{}
-		url, err = e.client.makeRequest(ctx, "GET", url, &page)
-		resp = append(resp, page...)
-	}
+// CreateEvent can create a new event and return the result
+func (e *Events) CreateEvent(ctx context.Context, event *EventRequest) (*Event, error) {
+	return e.Create(ctx, event)
+}
 
-	return &resp, err
+// UpdateEvent can update an event and return the result
+func (e *Events) UpdateEvent(ctx context.Context, id int, event *EventRequest) (*Event, error) {
+	return e.Update(ctx, id, event)
 }
 
-// GetEvent can return information about a single event
-func (e *Events) GetEvent(ctx context.Context, id int) (*Event, error) {
+// PatchEvent can update only the given fields of an event and return the result
+func (e *Events) PatchEvent(ctx context.Context, id int, fields map[string]interface{}) (*Event, error) {
+	return e.Patch(ctx, id, fields)
+}
+
+// DeleteEvent can delete an event
+func (e *Events) DeleteEvent(ctx context.Context, id int) error {
+	return e.Delete(ctx, id)
+}
+
+// GetID implements Entity
+func (e *Event) GetID() int {
+	return e.ID
+}
 
-	resp := Event{}
-	_, err := e.client.makeRequest(ctx, "GET", fmt.Sprintf("%s/%d", e.urlPrefix, id), &resp)
-	return &resp, err
+// GetEntityID implements Entity
+func (e *Event) GetEntityID() int {
+	return e.EntityID
 }
+
+// GetName implements Entity
+func (e *Event) GetName() string {
+	return e.Name
+}
+
+// EntityType implements Entity
+func (e *Event) EntityType() string {
+	return "event"
+}
+
+// GetEntry implements Entity
+func (e *Event) GetEntry() string {
+	return e.Entry
+}
+
+// GetType implements Entity
+func (e *Event) GetType() string {
+	return e.Type
+}
+
+// GetIsPrivate implements Entity
+func (e *Event) GetIsPrivate() bool {
+	return e.IsPrivate
+}
+
+// GetImage implements Entity
+func (e *Event) GetImage() string {
+	return e.Image
+}
+
+// GetImageThumb implements Entity
+func (e *Event) GetImageThumb() string {
+	return e.ImageThumb
+}
+
+// GetCreatedAt implements Entity
+func (e *Event) GetCreatedAt() time.Time {
+	return e.CreatedAt
+}
+
+// GetCreatedBy implements Entity
+func (e *Event) GetCreatedBy() int {
+	return e.CreatedBy
+}
+
+// GetUpdatedAt implements Entity
+func (e *Event) GetUpdatedAt() time.Time {
+	return e.UpdatedAt
+}
+
+// GetUpdatedBy implements Entity
+func (e *Event) GetUpdatedBy() int {
+	return e.UpdatedBy
+}
+
+// EventPager is used to iterate over pages of events
+type EventPager = Pager[Event]
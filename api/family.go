@@ -2,15 +2,13 @@ package kanka
 
 import (
 	"context"
-	"fmt"
+	"net/url"
 	"time"
 )
 
 // Families is used to query the families endpoints
 type Families struct {
-	client     *Client
-	campaignID int
-	urlPrefix  string
+	*EntityService[Family, FamilyRequest]
 }
 
 // Family is used to serialize a family object
@@ -37,42 +35,159 @@ type Family struct {
 	UpdatedBy int       `json:"updated_by"`
 }
 
+// FamilyRequest is used to create or update a family
+type FamilyRequest struct {
+	Name       string  `json:"name"`
+	Entry      *string `json:"entry,omitempty"`
+	LocationID *int    `json:"location_id,omitempty"`
+	FamilyID   *int    `json:"family_id,omitempty"`
+	Type       *string `json:"type,omitempty"`
+
+	IsPrivate *bool `json:"is_private,omitempty"`
+}
+
+// FamilyFilter is used to filter families in ListOptions
+type FamilyFilter struct {
+	FamilyID   int
+	LocationID int
+}
+
+// Apply implements Filter
+func (f FamilyFilter) Apply(q url.Values) {
+	setInt(q, "family_id", f.FamilyID)
+	setInt(q, "location_id", f.LocationID)
+}
+
 func init() {
 	addKnownLinkType("family", func(ctx context.Context, client *Client, campaignID int, entityID int) string {
 		family, _ := client.Families(campaignID).GetFamily(ctx, entityID)
 		return family.Name
 	})
+	addKnownSyncType("family", func(ctx context.Context, client *Client, campaignID int, opts *ListOptions) ([]SyncRecord, error) {
+		records := []SyncRecord{}
+		err := client.Families(campaignID).Iterate(ctx, opts, func(family *Family) error {
+			records = append(records, newSyncRecord(family))
+			return nil
+		})
+		return records, err
+	})
+	addKnownEntityType("family", func(ctx context.Context, client *Client, campaignID int, id int) (Entity, error) {
+		return client.Families(campaignID).GetFamily(ctx, id)
+	})
 }
 
 // Families returns a handle on the families endpoint
 func (c *Client) Families(campaignID int) *Families {
 	return &Families{
-		client:     c,
-		campaignID: campaignID,
-		urlPrefix:  fmt.Sprintf("/campaigns/%d/families", campaignID),
+		EntityService: newEntityService[Family, FamilyRequest](c, campaignID, "families"),
 	}
 }
 
 // GetFamilies can return information about all families
 func (f *Families) GetFamilies(ctx context.Context) (*[]Family, error) {
+	return f.GetAll(ctx)
+}
 
-	var err error
-	resp := []Family{}
-	url := f.urlPrefix
+// GetFamily can return information about a single family
+func (f *Families) GetFamily(ctx context.Context, id int) (*Family, error) {
+	return f.Get(ctx, id)
+}
 
-	for len(url) > 0 && err == nil {
-		page := []Family{}
-		url, err = f.client.makeRequest(ctx, "GET", url, &page)
-		resp = append(resp, page...)
-	}
+// GetFamilyWithPosts can return information about a single family along with all of its posts
+func (f *Families) GetFamilyWithPosts(ctx context.Context, id int) (*Family, *[]EntityPost, error) {
+	return getWithPosts(ctx, f.EntityService, id)
+}
 
-	return &resp, err
+// CreateFamily can create a new family and return the result
+func (f *Families) CreateFamily(ctx context.Context, family *FamilyRequest) (*Family, error) {
+	return f.Create(ctx, family)
 }
 
-// GetFamily can return information about a single family
-func (f *Families) GetFamily(ctx context.Context, id int) (*Family, error) {
+// UpdateFamily can update a family and return the result
+func (f *Families) UpdateFamily(ctx context.Context, id int, family *FamilyRequest) (*Family, error) {
+	return f.Update(ctx, id, family)
+}
+
+// PatchFamily can update only the given fields of a family and return the result
+func (f *Families) PatchFamily(ctx context.Context, id int, fields map[string]interface{}) (*Family, error) {
+	return f.Patch(ctx, id, fields)
+}
+
+// DeleteFamily can delete a family
+func (f *Families) DeleteFamily(ctx context.Context, id int) error {
+	return f.Delete(ctx, id)
+}
 
-	resp := Family{}
-	_, err := f.client.makeRequest(ctx, "GET", fmt.Sprintf("%s/%d", f.urlPrefix, id), &resp)
-	return &resp, err
+// GetMembers can return information about all characters belonging to a given family
+func (f *Families) GetMembers(ctx context.Context, id int) (*[]Character, error) {
+	opts := &ListOptions{Filter: CharacterFilter{FamilyID: id}}
+	return f.client.Characters(f.campaignID).getAllMatching(ctx, opts)
 }
+
+// GetID implements Entity
+func (f *Family) GetID() int {
+	return f.ID
+}
+
+// GetEntityID implements Entity
+func (f *Family) GetEntityID() int {
+	return f.EntityID
+}
+
+// GetName implements Entity
+func (f *Family) GetName() string {
+	return f.Name
+}
+
+// EntityType implements Entity
+func (f *Family) EntityType() string {
+	return "family"
+}
+
+// GetEntry implements Entity
+func (f *Family) GetEntry() string {
+	return f.Entry
+}
+
+// GetType implements Entity
+func (f *Family) GetType() string {
+	return f.Type
+}
+
+// GetIsPrivate implements Entity
+func (f *Family) GetIsPrivate() bool {
+	return f.IsPrivate
+}
+
+// GetImage implements Entity
+func (f *Family) GetImage() string {
+	return f.Image
+}
+
+// GetImageThumb implements Entity
+func (f *Family) GetImageThumb() string {
+	return f.ImageThumb
+}
+
+// GetCreatedAt implements Entity
+func (f *Family) GetCreatedAt() time.Time {
+	return f.CreatedAt
+}
+
+// GetCreatedBy implements Entity
+func (f *Family) GetCreatedBy() int {
+	return f.CreatedBy
+}
+
+// GetUpdatedAt implements Entity
+func (f *Family) GetUpdatedAt() time.Time {
+	return f.UpdatedAt
+}
+
+// GetUpdatedBy implements Entity
+func (f *Family) GetUpdatedBy() int {
+	return f.UpdatedBy
+}
+
+// FamilyPager is used to iterate over pages of families
+type FamilyPager = Pager[Family]
@@ -2,6 +2,8 @@ package kanka
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
@@ -95,3 +97,30 @@ func TestGetFamily(t *testing.T) {
 		assert.Equal(t, 1, f.UpdatedBy)
 	}
 }
+
+func TestGetFamilyMembers(t *testing.T) {
+
+	// Family members are the characters filtered by their family
+	testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "/campaigns/1/characters", req.URL.Path)
+		assert.Equal(t, "34", req.URL.Query().Get("family_id"))
+
+		res.Header().Set("Content-Type", "application/json")
+		res.WriteHeader(200)
+		_, err := res.Write([]byte(`{"data": [{"id": 1, "name": "Jonathan Green", "family_id": 34}], "links": {"next": null}}`))
+		assert.NoError(t, err)
+	}))
+	defer testServer.Close()
+
+	config := DefaultConfig()
+	config.BaseURL = testServer.URL
+	config.ForceTLS = false
+	client := NewClient(config)
+
+	members, err := client.Families(1).GetMembers(context.Background(), 34)
+
+	if assert.NoError(t, err) && assert.Len(t, *members, 1) {
+		assert.Equal(t, "Jonathan Green", (*members)[0].Name)
+		assert.Equal(t, 34, (*members)[0].FamilyID)
+	}
+}
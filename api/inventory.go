@@ -0,0 +1,108 @@
+package kanka
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Inventory is used to query the inventory endpoints of an entity, e.g. a character's belongings
+type Inventory struct {
+	*EntityService[InventoryEntry, InventoryEntryRequest]
+}
+
+// InventoryEntry is used to serialize an inventory object. An entry either refers to an item by ItemID,
+// or names something which isn't an item in the campaign.
+type InventoryEntry struct {
+	ID         int  `json:"id"`
+	EntityID   int  `json:"entity_id"`
+	ItemID     int  `json:"item_id"`
+	IsEquipped bool `json:"is_equipped"`
+
+	Name        string `json:"name"`
+	Amount      int    `json:"amount"`
+	Position    string `json:"position"`
+	Description string `json:"description"`
+
+	Visibility   string `json:"visibility"`
+	VisibilityID int    `json:"visibility_id"`
+
+	CreatedAt time.Time `json:"created_at"`
+	CreatedBy int       `json:"created_by"`
+	UpdatedAt time.Time `json:"updated_at"`
+	UpdatedBy int       `json:"updated_by"`
+}
+
+// InventoryEntryRequest is used to create or update an inventory entry
+type InventoryEntryRequest struct {
+	ItemID      *int    `json:"item_id,omitempty"`
+	Name        *string `json:"name,omitempty"`
+	Amount      int     `json:"amount"`
+	Position    *string `json:"position,omitempty"`
+	Description *string `json:"description,omitempty"`
+	IsEquipped  *bool   `json:"is_equipped,omitempty"`
+
+	Visibility   *string `json:"visibility,omitempty"`
+	VisibilityID *int    `json:"visibility_id,omitempty"`
+}
+
+// InventoryItem is an inventory entry joined with the item it refers to.
+// Item is nil for entries which don't refer to an item.
+type InventoryItem struct {
+	InventoryEntry
+	Item *Item
+}
+
+// Inventory returns a handle on the inventory endpoints of an entity
+func (c *Client) Inventory(campaignID int, entityID int) *Inventory {
+	return &Inventory{
+		EntityService: newEntityService[InventoryEntry, InventoryEntryRequest](c, campaignID, fmt.Sprintf("entities/%d/inventory", entityID)),
+	}
+}
+
+// GetInventory can return information about all inventory entries of the entity
+func (i *Inventory) GetInventory(ctx context.Context) (*[]InventoryEntry, error) {
+	return i.GetAll(ctx)
+}
+
+// GetInventoryEntry can return information about a single inventory entry
+func (i *Inventory) GetInventoryEntry(ctx context.Context, id int) (*InventoryEntry, error) {
+	return i.Get(ctx, id)
+}
+
+// CreateInventoryEntry can create a new inventory entry and return the result
+func (i *Inventory) CreateInventoryEntry(ctx context.Context, entry *InventoryEntryRequest) (*InventoryEntry, error) {
+	return i.Create(ctx, entry)
+}
+
+// UpdateInventoryEntry can update an inventory entry and return the result
+func (i *Inventory) UpdateInventoryEntry(ctx context.Context, id int, entry *InventoryEntryRequest) (*InventoryEntry, error) {
+	return i.Update(ctx, id, entry)
+}
+
+// PatchInventoryEntry can update only the given fields of an inventory entry and return the result
+func (i *Inventory) PatchInventoryEntry(ctx context.Context, id int, fields map[string]interface{}) (*InventoryEntry, error) {
+	return i.Patch(ctx, id, fields)
+}
+
+// DeleteInventoryEntry can delete an inventory entry
+func (i *Inventory) DeleteInventoryEntry(ctx context.Context, id int) error {
+	return i.Delete(ctx, id)
+}
+
+// InventoryOf returns the inventory of an entity joined with the items it refers to.
+// Each item is fetched once, concurrently. If some items can't be fetched, the inventory is still
+// returned (with a nil Item for those entries) along with the error for the lowest failed item ID.
+func (c *Client) InventoryOf(ctx context.Context, campaignID int, entityID int) ([]InventoryItem, error) {
+
+	entries, err := c.Inventory(campaignID, entityID).GetInventory(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return joinMany(ctx, c.Items(campaignID).EntityService, "item", *entries,
+		func(entry InventoryEntry) int { return entry.ItemID },
+		func(entry InventoryEntry, item *Item) InventoryItem {
+			return InventoryItem{InventoryEntry: entry, Item: item}
+		})
+}
@@ -0,0 +1,117 @@
+package kanka
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInventory(t *testing.T) {
+
+	client := NewClient(DefaultConfig())
+	i := client.Inventory(1, 4)
+
+	assert.Equal(t, client, i.client)
+	assert.Equal(t, "/campaigns/1/entities/4/inventory", i.urlPrefix)
+}
+
+func TestGetInventory(t *testing.T) {
+
+	testServer, config := mockTestServer()
+	defer testServer.Close()
+	client := NewClient(config)
+	ctx := context.Background()
+
+	inventory, err := client.Inventory(1, 4).GetInventory(ctx)
+
+	if assert.NoError(t, err) {
+		assert.Len(t, *inventory, 3)
+
+		i := (*inventory)[0]
+		assert.Equal(t, 1, i.ID)
+		assert.Equal(t, 4, i.EntityID)
+		assert.Equal(t, 1, i.ItemID)
+		assert.Equal(t, 1, i.Amount)
+		assert.Equal(t, "Hand", i.Position)
+		assert.Equal(t, true, i.IsEquipped)
+		assert.Equal(t, "all", i.Visibility)
+
+		// Entries don't have to refer to an item
+		assert.Equal(t, 0, (*inventory)[1].ItemID)
+		assert.Equal(t, "Gold coins", (*inventory)[1].Name)
+	}
+}
+
+func TestGetInventoryEntry(t *testing.T) {
+
+	testServer, config := mockTestServer()
+	defer testServer.Close()
+	client := NewClient(config)
+	ctx := context.Background()
+
+	entry, err := client.Inventory(1, 4).GetInventoryEntry(ctx, 1)
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, 1, entry.ID)
+		assert.Equal(t, 1, entry.ItemID)
+	}
+}
+
+func TestWriteInventory(t *testing.T) {
+
+	testServer, config, requests := recordingTestServer()
+	defer testServer.Close()
+	client := NewClient(config)
+	ctx := context.Background()
+	inventory := client.Inventory(1, 4)
+
+	_, err := inventory.CreateInventoryEntry(ctx, &InventoryEntryRequest{Name: String("Rope"), Amount: 2, Position: String("Backpack")})
+	assert.NoError(t, err)
+	assert.Equal(t, recordedRequest{Method: "POST", Path: "/campaigns/1/entities/4/inventory", Body: `{"name":"Rope","amount":2,"position":"Backpack"}`}, requests.last())
+
+	_, err = inventory.UpdateInventoryEntry(ctx, 1, &InventoryEntryRequest{ItemID: Int(1), Amount: 0, IsEquipped: Bool(false)})
+	assert.NoError(t, err)
+	assert.Equal(t, recordedRequest{Method: "PUT", Path: "/campaigns/1/entities/4/inventory/1", Body: `{"item_id":1,"amount":0,"is_equipped":false}`}, requests.last())
+
+	_, err = inventory.PatchInventoryEntry(ctx, 1, map[string]interface{}{"is_equipped": true})
+	assert.NoError(t, err)
+	assert.Equal(t, recordedRequest{Method: "PATCH", Path: "/campaigns/1/entities/4/inventory/1", Body: `{"is_equipped":true}`}, requests.last())
+
+	assert.NoError(t, inventory.DeleteInventoryEntry(ctx, 1))
+	assert.Equal(t, recordedRequest{Method: "DELETE", Path: "/campaigns/1/entities/4/inventory/1"}, requests.last())
+}
+
+func TestInventoryOf(t *testing.T) {
+
+	testServer, config := mockTestServer()
+	defer testServer.Close()
+	client := NewClient(config)
+	ctx := context.Background()
+
+	inventory, err := client.InventoryOf(ctx, 1, 4)
+
+	if assert.NoError(t, err) && assert.Len(t, inventory, 3) {
+		if assert.NotNil(t, inventory[0].Item) {
+			assert.Equal(t, "Spear", inventory[0].Item.Name)
+		}
+		assert.Equal(t, "Hand", inventory[0].Position)
+
+		assert.Nil(t, inventory[1].Item)
+		assert.Equal(t, "Gold coins", inventory[1].Name)
+
+		// Items are shared between entries that refer to the same item
+		assert.Same(t, inventory[0].Item, inventory[2].Item)
+	}
+
+	// Missing items are reported, but the rest of the inventory is still returned
+	config.Retry = nil
+	client = NewClient(config)
+
+	inventory, err = client.InventoryOf(ctx, 1, 6)
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.Contains(t, err.Error(), "fetching item 404")
+	if assert.Len(t, inventory, 1) {
+		assert.Nil(t, inventory[0].Item)
+	}
+}
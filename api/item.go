@@ -2,15 +2,13 @@ package kanka
 
 import (
 	"context"
-	"fmt"
+	"net/url"
 	"time"
 )
 
 // Items is used to query the items endpoints
 type Items struct {
-	client     *Client
-	campaignID int
-	urlPrefix  string
+	*EntityService[Item, ItemRequest]
 }
 
 // Item is used to serialize an item object
@@ -38,43 +36,155 @@ type Item struct {
 	UpdatedBy int       `json:"updated_by"`
 }
 
+// ItemRequest is used to create or update an item
+type ItemRequest struct {
+	Name        string  `json:"name"`
+	Entry       *string `json:"entry,omitempty"`
+	LocationID  *int    `json:"location_id,omitempty"`
+	CharacterID *int    `json:"character_id,omitempty"`
+	Price       *string `json:"price,omitempty"`
+	Size        *string `json:"size,omitempty"`
+	Type        *string `json:"type,omitempty"`
+
+	IsPrivate *bool `json:"is_private,omitempty"`
+}
+
+// ItemFilter is used to filter items in ListOptions
+type ItemFilter struct {
+	CharacterID int
+	LocationID  int
+}
+
+// Apply implements Filter
+func (f ItemFilter) Apply(q url.Values) {
+	setInt(q, "character_id", f.CharacterID)
+	setInt(q, "location_id", f.LocationID)
+}
+
 func init() {
 	addKnownLinkType("item", func(ctx context.Context, client *Client, campaignID int, entityID int) string {
 		item, _ := client.Items(campaignID).GetItem(ctx, entityID)
 		return item.Name
 	})
+	addKnownSyncType("item", func(ctx context.Context, client *Client, campaignID int, opts *ListOptions) ([]SyncRecord, error) {
+		records := []SyncRecord{}
+		err := client.Items(campaignID).Iterate(ctx, opts, func(item *Item) error {
+			records = append(records, newSyncRecord(item))
+			return nil
+		})
+		return records, err
+	})
+	addKnownEntityType("item", func(ctx context.Context, client *Client, campaignID int, id int) (Entity, error) {
+		return client.Items(campaignID).GetItem(ctx, id)
+	})
 }
 
 // Items returns a handle of the items endpoint
 func (c *Client) Items(campaignID int) *Items {
 	return &Items{
-		client:     c,
-		campaignID: campaignID,
-		urlPrefix:  fmt.Sprintf("/campaigns/%d/items", campaignID),
+		EntityService: newEntityService[Item, ItemRequest](c, campaignID, "items"),
 	}
 }
 
 // GetItems can return information about all items
 func (i *Items) GetItems(ctx context.Context) (*[]Item, error) {
+	return i.GetAll(ctx)
+}
 
-	var err error
-	resp := []Item{}
-	url := i.urlPrefix
+// GetItem can return information about a single item
+func (i *Items) GetItem(ctx context.Context, id int) (*Item, error) {
+	return i.Get(ctx, id)
+}
 
-	for len(url) > 0 && err == nil {
-		page := []Item{}
-		url, err = i.client.makeRequest(ctx, "GET", url, &page)
-		resp = append(resp, page...)
-	}
+// GetItemWithPosts can return information about a single item along with all of its posts
+func (i *Items) GetItemWithPosts(ctx context.Context, id int) (*Item, *[]EntityPost, error) {
+	return getWithPosts(ctx, i.EntityService, id)
+}
 
-	return &resp, err
+// CreateItem can create a new item and return the result
+func (i *Items) CreateItem(ctx context.Context, item *ItemRequest) (*Item, error) {
+	return i.Create(ctx, item)
 }
 
-// GetItem can return information about a single item
-func (i *Items) GetItem(ctx context.Context, id int) (*Item, error) {
+// UpdateItem can update an item and return the result
+func (i *Items) UpdateItem(ctx context.Context, id int, item *ItemRequest) (*Item, error) {
+	return i.Update(ctx, id, item)
+}
+
+// PatchItem can update only the given fields of an item and return the result
+func (i *Items) PatchItem(ctx context.Context, id int, fields map[string]interface{}) (*Item, error) {
+	return i.Patch(ctx, id, fields)
+}
+
+// DeleteItem can delete an item
+func (i *Items) DeleteItem(ctx context.Context, id int) error {
+	return i.Delete(ctx, id)
+}
+
+// GetID implements Entity
+func (i *Item) GetID() int {
+	return i.ID
+}
+
+// GetEntityID implements Entity
+func (i *Item) GetEntityID() int {
+	return i.EntityID
+}
+
+// GetName implements Entity
+func (i *Item) GetName() string {
+	return i.Name
+}
+
+// EntityType implements Entity
+func (i *Item) EntityType() string {
+	return "item"
+}
+
+// GetEntry implements Entity
+func (i *Item) GetEntry() string {
+	return i.Entry
+}
 
-	resp := Item{}
-	_, err := i.client.makeRequest(ctx, "GET", fmt.Sprintf("%s/%d", i.urlPrefix, id), &resp)
+// GetType implements Entity
+func (i *Item) GetType() string {
+	return i.Type
+}
+
+// GetIsPrivate implements Entity
+func (i *Item) GetIsPrivate() bool {
+	return i.IsPrivate
+}
 
-	return &resp, err
+// GetImage implements Entity
+func (i *Item) GetImage() string {
+	return i.Image
 }
+
+// GetImageThumb implements Entity
+func (i *Item) GetImageThumb() string {
+	return i.ImageThumb
+}
+
+// GetCreatedAt implements Entity
+func (i *Item) GetCreatedAt() time.Time {
+	return i.CreatedAt
+}
+
+// GetCreatedBy implements Entity
+func (i *Item) GetCreatedBy() int {
+	return i.CreatedBy
+}
+
+// GetUpdatedAt implements Entity
+func (i *Item) GetUpdatedAt() time.Time {
+	return i.UpdatedAt
+}
+
+// GetUpdatedBy implements Entity
+func (i *Item) GetUpdatedBy() int {
+	return i.UpdatedBy
+}
+
+// ItemPager is used to iterate over pages of items
+type ItemPager = Pager[Item]
@@ -2,15 +2,13 @@ package kanka
 
 import (
 	"context"
-	"fmt"
+	"net/url"
 	"time"
 )
 
 // Journals is used to query the journals endpoints
 type Journals struct {
-	client     *Client
-	campaignID int
-	urlPrefix  string
+	*EntityService[Journal, JournalRequest]
 }
 
 // Journal is used to serialize an journal object
@@ -36,43 +34,151 @@ type Journal struct {
 	UpdatedBy int       `json:"updated_by"`
 }
 
+// JournalRequest is used to create or update a journal
+type JournalRequest struct {
+	Name        string  `json:"name"`
+	Entry       *string `json:"entry,omitempty"`
+	CharacterID *int    `json:"character_id,omitempty"`
+	Date        *string `json:"date,omitempty"`
+	Type        *string `json:"type,omitempty"`
+
+	IsPrivate *bool `json:"is_private,omitempty"`
+}
+
+// JournalFilter is used to filter journals in ListOptions
+type JournalFilter struct {
+	CharacterID int
+}
+
+// Apply implements Filter
+func (f JournalFilter) Apply(q url.Values) {
+	setInt(q, "character_id", f.CharacterID)
+}
+
 func init() {
 	addKnownLinkType("journal", func(ctx context.Context, client *Client, campaignID int, entityID int) string {
 		journal, _ := client.Journals(campaignID).GetJournal(ctx, entityID)
 		return journal.Name
 	})
+	addKnownSyncType("journal", func(ctx context.Context, client *Client, campaignID int, opts *ListOptions) ([]SyncRecord, error) {
+		records := []SyncRecord{}
+		err := client.Journals(campaignID).Iterate(ctx, opts, func(journal *Journal) error {
+			records = append(records, newSyncRecord(journal))
+			return nil
+		})
+		return records, err
+	})
+	addKnownEntityType("journal", func(ctx context.Context, client *Client, campaignID int, id int) (Entity, error) {
+		return client.Journals(campaignID).GetJournal(ctx, id)
+	})
 }
 
 // Journals returns a handle of the journals endpoint
 func (c *Client) Journals(campaignID int) *Journals {
 	return &Journals{
-		client:     c,
-		campaignID: campaignID,
-		urlPrefix:  fmt.Sprintf("/campaigns/%d/journals", campaignID),
+		EntityService: newEntityService[Journal, JournalRequest](c, campaignID, "journals"),
 	}
 }
 
 // GetJournals can return information about all journals
 func (j *Journals) GetJournals(ctx context.Context) (*[]Journal, error) {
+	return j.GetAll(ctx)
+}
 
-	var err error
-	resp := []Journal{}
-	url := j.urlPrefix
+// GetJournal can return information about a single journal
+func (j *Journals) GetJournal(ctx context.Context, id int) (*Journal, error) {
+	return j.Get(ctx, id)
+}
 
-	for len(url) > 0 && err == nil {
-		page := []Journal{}
-		url, err = j.client.makeRequest(ctx, "GET", url, &page)
-		resp = append(resp, page...)
-	}
+// GetJournalWithPosts can return information about a single journal along with all of its posts
+func (j *Journals) GetJournalWithPosts(ctx context.Context, id int) (*Journal, *[]EntityPost, error) {
+	return getWithPosts(ctx, j.EntityService, id)
+}
 
-	return &resp, err
+// CreateJournal can create a new journal and return the result
+func (j *Journals) CreateJournal(ctx context.Context, journal *JournalRequest) (*Journal, error) {
+	return j.Create(ctx, journal)
 }
 
-// GetJournal can return information about a single journal
-func (j *Journals) GetJournal(ctx context.Context, id int) (*Journal, error) {
+// UpdateJournal can update a journal and return the result
+func (j *Journals) UpdateJournal(ctx context.Context, id int, journal *JournalRequest) (*Journal, error) {
+	return j.Update(ctx, id, journal)
+}
+
+// PatchJournal can update only the given fields of a journal and return the result
+func (j *Journals) PatchJournal(ctx context.Context, id int, fields map[string]interface{}) (*Journal, error) {
+	return j.Patch(ctx, id, fields)
+}
+
+// DeleteJournal can delete a journal
+func (j *Journals) DeleteJournal(ctx context.Context, id int) error {
+	return j.Delete(ctx, id)
+}
+
+// GetID implements Entity
+func (j *Journal) GetID() int {
+	return j.ID
+}
+
+// GetEntityID implements Entity
+func (j *Journal) GetEntityID() int {
+	return j.EntityID
+}
+
+// GetName implements Entity
+func (j *Journal) GetName() string {
+	return j.Name
+}
+
+// EntityType implements Entity
+func (j *Journal) EntityType() string {
+	return "journal"
+}
+
+// GetEntry implements Entity
+func (j *Journal) GetEntry() string {
+	return j.Entry
+}
 
-	resp := Journal{}
-	_, err := j.client.makeRequest(ctx, "GET", fmt.Sprintf("%s/%d", j.urlPrefix, id), &resp)
+// GetType implements Entity
+func (j *Journal) GetType() string {
+	return j.Type
+}
+
+// GetIsPrivate implements Entity
+func (j *Journal) GetIsPrivate() bool {
+	return j.IsPrivate
+}
 
-	return &resp, err
+// GetImage implements Entity
+func (j *Journal) GetImage() string {
+	return j.Image
 }
+
+// GetImageThumb implements Entity
+func (j *Journal) GetImageThumb() string {
+	return j.ImageThumb
+}
+
+// GetCreatedAt implements Entity
+func (j *Journal) GetCreatedAt() time.Time {
+	return j.CreatedAt
+}
+
+// GetCreatedBy implements Entity
+func (j *Journal) GetCreatedBy() int {
+	return j.CreatedBy
+}
+
+// GetUpdatedAt implements Entity
+func (j *Journal) GetUpdatedAt() time.Time {
+	return j.UpdatedAt
+}
+
+// GetUpdatedBy implements Entity
+func (j *Journal) GetUpdatedBy() int {
+	return j.UpdatedBy
+}
+
+// JournalPager is used to iterate over pages of journals
+type JournalPager = Pager[Journal]
@@ -0,0 +1,220 @@
+package kanka
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// lastSyncFormat is the ISO 8601 format Kanka uses for timestamps
+const lastSyncFormat = "2006-01-02T15:04:05.000000Z07:00"
+
+// ListOptions is used to control which objects are returned by list endpoints.
+// Zero-valued options are not sent.
+type ListOptions struct {
+
+	// Name, Type, IsPrivate and Tags filter on the attributes shared by all entities.
+	// Entities must have all of the given tags to match.
+	Name      string
+	Type      string
+	IsPrivate *bool
+	Tags      []int
+
+	// LastSync only returns objects changed since the given time
+	LastSync time.Time
+
+	// Related includes related objects (e.g. attributes, posts, relations) in the response
+	Related bool
+
+	// OrderBy sorts by the given field, in descending order if Desc is set
+	OrderBy string
+	Desc    bool
+
+	// Page is the page to start listing from. Pass the page after a pager's Meta().CurrentPage
+	// to resume a listing that was interrupted. Defaults to the first page.
+	Page int
+
+	// PageSize is the number of objects per page. Defaults to Kanka's page size of 15.
+	PageSize int
+
+	// Filter adds filters specific to the type of entity being listed, e.g. CharacterFilter
+	Filter Filter
+}
+
+// Filter is implemented by the filters for each type of entity which can be passed in ListOptions
+type Filter interface {
+	// Apply adds the filter's query parameters to q
+	Apply(q url.Values)
+}
+
+// query encodes the options into URL query parameters
+func (o *ListOptions) query() url.Values {
+
+	q := url.Values{}
+	if o == nil {
+		return q
+	}
+
+	setString(q, "name", o.Name)
+	setString(q, "type", o.Type)
+	setBool(q, "is_private", o.IsPrivate)
+	for _, tag := range o.Tags {
+		q.Add("tags[]", strconv.Itoa(tag))
+	}
+
+	if !o.LastSync.IsZero() {
+		q.Set("lastSync", o.LastSync.UTC().Format(lastSyncFormat))
+	}
+
+	if o.Related {
+		q.Set("related", "1")
+	}
+
+	if o.OrderBy != "" {
+		q.Set("order", o.OrderBy)
+		if o.Desc {
+			q.Set("desc", "1")
+		}
+	}
+
+	if o.Page > 1 {
+		q.Set("page", strconv.Itoa(o.Page))
+	}
+
+	setInt(q, "limit", o.PageSize)
+
+	if o.Filter != nil {
+		o.Filter.Apply(q)
+	}
+
+	return q
+}
+
+// setString sets a query parameter if the value is not empty
+func setString(q url.Values, key string, value string) {
+	if value != "" {
+		q.Set(key, value)
+	}
+}
+
+// setInt sets a query parameter if the value is not zero
+func setInt(q url.Values, key string, value int) {
+	if value != 0 {
+		q.Set(key, strconv.Itoa(value))
+	}
+}
+
+// setBool sets a query parameter to 1 or 0 if the value is not nil
+func setBool(q url.Values, key string, value *bool) {
+	if value != nil {
+		if *value {
+			q.Set(key, "1")
+		} else {
+			q.Set(key, "0")
+		}
+	}
+}
+
+// Bool returns a pointer to the given bool, for use in ListOptions, filters and requests
+func Bool(value bool) *bool {
+	return &value
+}
+
+// Int returns a pointer to the given int, for use in requests
+func Int(value int) *int {
+	return &value
+}
+
+// String returns a pointer to the given string, for use in requests
+func String(value string) *string {
+	return &value
+}
+
+// pager implements the page-by-page iteration shared by all entity pagers.
+// Entity pagers embed it and decode each page into their own slice type.
+type pager struct {
+	client *Client
+	url    string
+	query  url.Values
+	done   bool
+
+	links Links
+	meta  Meta
+	err   error
+}
+
+// newPager returns a pager starting at the given endpoint
+func newPager(client *Client, endpoint string, opts *ListOptions) pager {
+
+	query := opts.query()
+	if encoded := query.Encode(); encoded != "" {
+		endpoint = endpoint + "?" + encoded
+	}
+
+	return pager{
+		client: client,
+		url:    endpoint,
+		query:  query,
+	}
+}
+
+// next fetches the next page into v, returning false if there are no more pages or the request failed.
+// A failed page is requested again on the next call, so callers may retry or give up as they see fit.
+func (p *pager) next(ctx context.Context, v interface{}) bool {
+
+	if p.done {
+		return false
+	}
+
+	resp, err := p.client.doRequest(ctx, "GET", p.url, nil, v)
+	p.err = err
+	if err != nil {
+		return false
+	}
+
+	p.links = resp.Links
+	p.meta = resp.Meta
+
+	if next := resp.nextPage(); next != "" {
+		p.url = p.withQuery(next)
+	} else {
+		p.done = true
+	}
+
+	return true
+}
+
+// withQuery adds any query parameters of the listing which are missing from a pagination link
+func (p *pager) withQuery(link string) string {
+
+	u, err := url.Parse(link)
+	if err != nil || len(p.query) == 0 {
+		return link
+	}
+
+	q := u.Query()
+	for key, values := range p.query {
+		if _, ok := q[key]; !ok {
+			q[key] = values
+		}
+	}
+	u.RawQuery = q.Encode()
+
+	return u.String()
+}
+
+// Links returns the pagination links of the current page
+func (p *pager) Links() Links {
+	return p.links
+}
+
+// Meta returns the pagination metadata of the current page
+func (p *pager) Meta() Meta {
+	return p.meta
+}
+
+// Err returns the error which stopped the pager, if any
+func (p *pager) Err() error {
+	return p.err
+}
@@ -0,0 +1,185 @@
+package kanka
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// paginatedTestServer returns a server with three pages of characters, one character per page.
+// Requests for the page given by failPage fail once.
+func paginatedTestServer(t *testing.T, failPage int) *httptest.Server {
+
+	var server *httptest.Server
+	failed := false
+
+	server = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+
+		page, err := strconv.Atoi(req.URL.Query().Get("page"))
+		if err != nil {
+			page = 1
+		}
+
+		if page == failPage && !failed {
+			failed = true
+			res.WriteHeader(404)
+			return
+		}
+
+		// Echo the name filter so tests can check it survives pagination
+		name := req.URL.Query().Get("name")
+
+		// Kanka's pagination links only carry the page number
+		next := "null"
+		if page < 3 {
+			next = fmt.Sprintf(`"%s%s?page=%d"`, server.URL, req.URL.Path, page+1)
+		}
+
+		res.Header().Set("Content-Type", "application/json")
+		res.WriteHeader(200)
+		_, err = res.Write([]byte(fmt.Sprintf(
+			`{"data": [{"id": %d, "name": "%s"}], "links": {"next": %s}, "meta": {"current_page": %d, "last_page": 3}}`,
+			page, name, next, page,
+		)))
+		assert.NoError(t, err)
+	}))
+
+	return server
+}
+
+func TestListOptionsQuery(t *testing.T) {
+
+	var opts *ListOptions
+	assert.Equal(t, "", opts.query().Encode())
+
+	opts = &ListOptions{Page: 1}
+	assert.Equal(t, "", opts.query().Encode())
+
+	opts = &ListOptions{
+		Name:      "Jon & Arya",
+		Type:      "NPC",
+		IsPrivate: Bool(false),
+		Tags:      []int{3, 5},
+		LastSync:  time.Date(2021, time.January, 28, 15, 15, 42, 0, time.FixedZone("EST", -5*60*60)),
+		Related:   true,
+		OrderBy:   "name",
+		Desc:      true,
+		Page:      4,
+		PageSize:  45,
+	}
+
+	q := opts.query()
+	assert.Equal(t, "Jon & Arya", q.Get("name"))
+	assert.Equal(t, "NPC", q.Get("type"))
+	assert.Equal(t, "0", q.Get("is_private"))
+	assert.Equal(t, []string{"3", "5"}, q["tags[]"])
+	assert.Equal(t, "2021-01-28T20:15:42.000000Z", q.Get("lastSync"))
+	assert.Equal(t, "1", q.Get("related"))
+	assert.Equal(t, "name", q.Get("order"))
+	assert.Equal(t, "1", q.Get("desc"))
+	assert.Equal(t, "4", q.Get("page"))
+	assert.Equal(t, "45", q.Get("limit"))
+
+	// Values are escaped when encoded
+	assert.Contains(t, q.Encode(), "name=Jon+%26+Arya")
+	assert.Contains(t, q.Encode(), "tags%5B%5D=3&tags%5B%5D=5")
+}
+
+func TestListFilters(t *testing.T) {
+
+	// Only set filters are encoded
+	opts := &ListOptions{Filter: CharacterFilter{IsDead: Bool(true), RaceID: 3, Sex: "Female"}}
+	assert.Equal(t, "is_dead=1&race_id=3&sex=Female", opts.query().Encode())
+
+	opts = &ListOptions{Filter: &LocationFilter{ParentLocationID: 7}}
+	assert.Equal(t, "parent_location_id=7", opts.query().Encode())
+
+	opts = &ListOptions{Type: "Quest", Filter: QuestFilter{IsCompleted: Bool(false), CharacterID: 2}}
+	assert.Equal(t, "character_id=2&is_completed=0&type=Quest", opts.query().Encode())
+}
+
+func TestPager(t *testing.T) {
+
+	testServer := paginatedTestServer(t, 0)
+	defer testServer.Close()
+
+	config := DefaultConfig()
+	config.BaseURL = testServer.URL
+	config.ForceTLS = false
+
+	client := NewClient(config)
+	ctx := context.Background()
+
+	// Walk through all pages
+	ids := []int{}
+	pager := client.Characters(1).List(nil)
+	for pager.Next(ctx) {
+		for _, character := range pager.Page() {
+			ids = append(ids, character.ID)
+		}
+		assert.Equal(t, len(ids), pager.Meta().CurrentPage)
+	}
+
+	assert.NoError(t, pager.Err())
+	assert.Equal(t, []int{1, 2, 3}, ids)
+	assert.Equal(t, 3, pager.Meta().LastPage)
+
+	// Resume from a later page
+	pager = client.Characters(1).List(&ListOptions{Page: 3})
+	if assert.True(t, pager.Next(ctx)) {
+		assert.Equal(t, 3, pager.Page()[0].ID)
+	}
+	assert.False(t, pager.Next(ctx))
+
+	// Query parameters are kept when following pagination links
+	pages := 0
+	pager = client.Characters(1).List(&ListOptions{Name: "Jon"})
+	for pager.Next(ctx) {
+		assert.Equal(t, "Jon", pager.Page()[0].Name)
+		pages++
+	}
+	assert.NoError(t, pager.Err())
+	assert.Equal(t, 3, pages)
+}
+
+func TestPagerErrors(t *testing.T) {
+
+	testServer := paginatedTestServer(t, 2)
+	defer testServer.Close()
+
+	config := DefaultConfig()
+	config.BaseURL = testServer.URL
+	config.ForceTLS = false
+
+	client := NewClient(config)
+	ctx := context.Background()
+
+	// The pager stops at the failed page
+	pager := client.Characters(1).List(nil)
+	assert.True(t, pager.Next(ctx))
+	assert.False(t, pager.Next(ctx))
+	assert.True(t, errors.Is(pager.Err(), ErrNotFound))
+	assert.Equal(t, 1, pager.Meta().CurrentPage)
+
+	// Calling Next again retries the failed page and carries on
+	assert.True(t, pager.Next(ctx))
+	assert.NoError(t, pager.Err())
+	assert.Equal(t, 2, pager.Page()[0].ID)
+	assert.True(t, pager.Next(ctx))
+	assert.False(t, pager.Next(ctx))
+
+	// Cancelled contexts stop the pager
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+
+	pager = client.Characters(1).List(nil)
+	assert.False(t, pager.Next(cancelled))
+	assert.True(t, errors.Is(pager.Err(), context.Canceled))
+}
@@ -3,14 +3,13 @@ package kanka
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"time"
 )
 
 // Locations is used to query the locations endpoints
 type Locations struct {
-	client     *Client
-	campaignID int
-	urlPrefix  string
+	*EntityService[Location, LocationRequest]
 }
 
 // Location is used to serialize a location object
@@ -37,6 +36,17 @@ type Location struct {
 	UpdatedBy int       `json:"updated_by"`
 }
 
+// LocationRequest is used to create or update a location
+type LocationRequest struct {
+	Name             string  `json:"name"`
+	Entry            *string `json:"entry,omitempty"`
+	ParentLocationID *int    `json:"parent_location_id,omitempty"`
+	IsMapPrivate     *int    `json:"is_map_private,omitempty"`
+	Type             *string `json:"type,omitempty"`
+
+	IsPrivate *bool `json:"is_private,omitempty"`
+}
+
 // MapPoint is used to serialize a map point object
 type MapPoint struct {
 	Name           string `json:"name"`
@@ -53,44 +63,54 @@ type MapPoint struct {
 	UpdatedAt time.Time `json:"updated_at"`
 }
 
+// LocationFilter is used to filter locations in ListOptions
+type LocationFilter struct {
+	ParentLocationID int
+}
+
+// Apply implements Filter
+func (f LocationFilter) Apply(q url.Values) {
+	setInt(q, "parent_location_id", f.ParentLocationID)
+}
+
 func init() {
 	addKnownLinkType("location", func(ctx context.Context, client *Client, campaignID int, entityID int) string {
 		location, _ := client.Locations(campaignID).GetLocation(ctx, entityID)
 		return location.Name
 	})
+	addKnownSyncType("location", func(ctx context.Context, client *Client, campaignID int, opts *ListOptions) ([]SyncRecord, error) {
+		records := []SyncRecord{}
+		err := client.Locations(campaignID).Iterate(ctx, opts, func(location *Location) error {
+			records = append(records, newSyncRecord(location))
+			return nil
+		})
+		return records, err
+	})
+	addKnownEntityType("location", func(ctx context.Context, client *Client, campaignID int, id int) (Entity, error) {
+		return client.Locations(campaignID).GetLocation(ctx, id)
+	})
 }
 
 // Locations returns a handle on the locations endpoints
 func (c *Client) Locations(campaignID int) *Locations {
 	return &Locations{
-		client:     c,
-		campaignID: campaignID,
-		urlPrefix:  fmt.Sprintf("/campaigns/%d/locations", campaignID),
+		EntityService: newEntityService[Location, LocationRequest](c, campaignID, "locations"),
 	}
 }
 
 // GetLocations can return information about all locations
 func (l *Locations) GetLocations(ctx context.Context) (*[]Location, error) {
-
-	var err error
-	resp := []Location{}
-	url := l.urlPrefix
-
-	for len(url) > 0 && err == nil {
-		page := []Location{}
-		url, err = l.client.makeRequest(ctx, "GET", url, &page)
-		resp = append(resp, page...)
-	}
-
-	return &resp, err
+	return l.GetAll(ctx)
 }
 
 // GetLocation can return information about a single location
 func (l *Locations) GetLocation(ctx context.Context, id int) (*Location, error) {
+	return l.Get(ctx, id)
+}
 
-	resp := Location{}
-	_, err := l.client.makeRequest(ctx, "GET", fmt.Sprintf("%s/%d", l.urlPrefix, id), &resp)
-	return &resp, err
+// GetLocationWithPosts can return information about a single location along with all of its posts
+func (l *Locations) GetLocationWithPosts(ctx context.Context, id int) (*Location, *[]EntityPost, error) {
+	return getWithPosts(ctx, l.EntityService, id)
 }
 
 // GetMapPoints can return the map points of a given location
@@ -101,3 +121,91 @@ func (l *Locations) GetMapPoints(ctx context.Context, id int) (*MapPoint, error)
 	_, err := l.client.makeRequest(ctx, "GET", fmt.Sprintf("%s/%d/map_points", l.urlPrefix, id), &resp)
 	return &resp, err
 }
+
+// CreateLocation can create a new location and return the result
+func (l *Locations) CreateLocation(ctx context.Context, location *LocationRequest) (*Location, error) {
+	return l.Create(ctx, location)
+}
+
+// UpdateLocation can update a location and return the result
+func (l *Locations) UpdateLocation(ctx context.Context, id int, location *LocationRequest) (*Location, error) {
+	return l.Update(ctx, id, location)
+}
+
+// PatchLocation can update only the given fields of a location and return the result
+func (l *Locations) PatchLocation(ctx context.Context, id int, fields map[string]interface{}) (*Location, error) {
+	return l.Patch(ctx, id, fields)
+}
+
+// DeleteLocation can delete a location
+func (l *Locations) DeleteLocation(ctx context.Context, id int) error {
+	return l.Delete(ctx, id)
+}
+
+// GetID implements Entity
+func (l *Location) GetID() int {
+	return l.ID
+}
+
+// GetEntityID implements Entity
+func (l *Location) GetEntityID() int {
+	return l.EntityID
+}
+
+// GetName implements Entity
+func (l *Location) GetName() string {
+	return l.Name
+}
+
+// EntityType implements Entity
+func (l *Location) EntityType() string {
+	return "location"
+}
+
+// GetEntry implements Entity
+func (l *Location) GetEntry() string {
+	return l.Entry
+}
+
+// GetType implements Entity
+func (l *Location) GetType() string {
+	return l.Type
+}
+
+// GetIsPrivate implements Entity
+func (l *Location) GetIsPrivate() bool {
+	return l.IsPrivate
+}
+
+// GetImage implements Entity
+func (l *Location) GetImage() string {
+	return l.Image
+}
+
+// GetImageThumb implements Entity
+func (l *Location) GetImageThumb() string {
+	return l.ImageThumb
+}
+
+// GetCreatedAt implements Entity
+func (l *Location) GetCreatedAt() time.Time {
+	return l.CreatedAt
+}
+
+// GetCreatedBy implements Entity
+func (l *Location) GetCreatedBy() int {
+	return l.CreatedBy
+}
+
+// GetUpdatedAt implements Entity
+func (l *Location) GetUpdatedAt() time.Time {
+	return l.UpdatedAt
+}
+
+// GetUpdatedBy implements Entity
+func (l *Location) GetUpdatedBy() int {
+	return l.UpdatedBy
+}
+
+// LocationPager is used to iterate over pages of locations
+type LocationPager = Pager[Location]
@@ -0,0 +1,158 @@
+package kanka
+
+import (
+	"context"
+	"strings"
+)
+
+// LocationPathSeparator separates the names of locations in LocationTree.Path
+const LocationPathSeparator = " › "
+
+// LocationTree is the geography of a campaign, built from each location's ParentLocationID
+type LocationTree struct {
+	tree tree[int, LocationNode, *LocationNode]
+}
+
+// LocationNode is a single location in a LocationTree, along with whatever has been attached to it
+type LocationNode struct {
+	Location Location
+	branch   treeLinks[LocationNode]
+
+	Characters    []Character
+	Items         []Item
+	Families      []Family
+	Organisations []Organisation
+}
+
+// BuildLocationTree fetches every location of a campaign into a tree
+func (c *Client) BuildLocationTree(ctx context.Context, campaignID int) (*LocationTree, error) {
+
+	locations, err := c.Locations(campaignID).GetLocations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewLocationTree(*locations), nil
+}
+
+// NewLocationTree returns the tree of the given locations.
+// Locations whose parent is missing, or whose parents form a loop, become roots.
+func NewLocationTree(locations []Location) *LocationTree {
+
+	nodes := make([]*LocationNode, 0, len(locations))
+	for _, location := range locations {
+		nodes = append(nodes, &LocationNode{Location: location})
+	}
+
+	return &LocationTree{tree: newTree[int, LocationNode](nodes)}
+}
+
+// Roots returns the locations without a parent, sorted by name
+func (t *LocationTree) Roots() []*LocationNode {
+	return t.tree.rootNodes()
+}
+
+// Node returns the node of the location with the given ID
+func (t *LocationTree) Node(locationID int) (*LocationNode, bool) {
+	return t.tree.node(locationID)
+}
+
+// Walk calls fn for every node of the tree, depth-first, parents before their children
+func (t *LocationTree) Walk(fn func(node *LocationNode, depth int)) {
+	t.tree.walk(fn)
+}
+
+// Ancestors returns the locations containing the location with the given ID, starting with its parent
+func (t *LocationTree) Ancestors(locationID int) []*LocationNode {
+	return t.tree.ancestors(locationID)
+}
+
+// Descendants returns the locations within the location with the given ID, depth-first, parents before their children
+func (t *LocationTree) Descendants(locationID int) []*LocationNode {
+	return t.tree.descendants(locationID)
+}
+
+// Path returns the names of the location with the given ID and its ancestors, starting from its root,
+// e.g. "Faerûn › Sword Coast › Waterdeep". Returns an empty string if the location isn't in the tree.
+func (t *LocationTree) Path(locationID int) string {
+
+	node, ok := t.tree.nodes[locationID]
+	if !ok {
+		return ""
+	}
+
+	names := []string{}
+	for current := node; current != nil; current = current.Parent() {
+		names = append([]string{current.Location.Name}, names...)
+	}
+
+	return strings.Join(names, LocationPathSeparator)
+}
+
+// Parent returns the node of the location's parent, or nil if it is a root
+func (n *LocationNode) Parent() *LocationNode {
+	return n.branch.parent
+}
+
+// Children returns the nodes of the locations within the location, sorted by name
+func (n *LocationNode) Children() []*LocationNode {
+	return n.branch.childNodes()
+}
+
+// AttachCharacters adds characters to the nodes of their LocationID.
+// Characters whose location isn't in the tree are ignored.
+func (t *LocationTree) AttachCharacters(characters []Character) {
+	for _, character := range characters {
+		if node, ok := t.tree.nodes[character.LocationID]; ok {
+			node.Characters = append(node.Characters, character)
+		}
+	}
+}
+
+// AttachItems adds items to the nodes of their LocationID.
+// Items whose location isn't in the tree are ignored.
+func (t *LocationTree) AttachItems(items []Item) {
+	for _, item := range items {
+		if node, ok := t.tree.nodes[item.LocationID]; ok {
+			node.Items = append(node.Items, item)
+		}
+	}
+}
+
+// AttachFamilies adds families to the nodes of their LocationID.
+// Families whose location isn't in the tree are ignored.
+func (t *LocationTree) AttachFamilies(families []Family) {
+	for _, family := range families {
+		if node, ok := t.tree.nodes[family.LocationID]; ok {
+			node.Families = append(node.Families, family)
+		}
+	}
+}
+
+// AttachOrganisations adds organisations to the nodes of their LocationID.
+// Organisations whose location isn't in the tree are ignored.
+func (t *LocationTree) AttachOrganisations(organisations []Organisation) {
+	for _, organisation := range organisations {
+		if node, ok := t.tree.nodes[organisation.LocationID]; ok {
+			node.Organisations = append(node.Organisations, organisation)
+		}
+	}
+}
+
+// keys implements treeNode
+func (n *LocationNode) keys() (int, int) {
+	return n.Location.ID, n.Location.ParentLocationID
+}
+
+// links implements treeNode
+func (n *LocationNode) links() *treeLinks[LocationNode] {
+	return &n.branch
+}
+
+// less sorts locations by name, then by ID
+func (n *LocationNode) less(other *LocationNode) bool {
+	if n.Location.Name != other.Location.Name {
+		return n.Location.Name < other.Location.Name
+	}
+	return n.Location.ID < other.Location.ID
+}
@@ -0,0 +1,124 @@
+package kanka
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testLocationTree returns a small world
+func testLocationTree() *LocationTree {
+	return NewLocationTree([]Location{
+		{ID: 1, Name: "Toril"},
+		{ID: 2, Name: "Faerûn", ParentLocationID: 1},
+		{ID: 3, Name: "Sword Coast", ParentLocationID: 2},
+		{ID: 4, Name: "Waterdeep", ParentLocationID: 3},
+		{ID: 5, Name: "Yawning Portal", ParentLocationID: 4},
+		{ID: 6, Name: "Baldur's Gate", ParentLocationID: 3},
+		{ID: 7, Name: "Abyss"},
+	})
+}
+
+// locationNames returns the names of the given nodes
+func locationNames(nodes []*LocationNode) []string {
+
+	names := []string{}
+	for _, node := range nodes {
+		names = append(names, node.Location.Name)
+	}
+
+	return names
+}
+
+func TestLocationTree(t *testing.T) {
+
+	tree := testLocationTree()
+
+	lines := []string{}
+	tree.Walk(func(node *LocationNode, depth int) {
+		lines = append(lines, strings.Repeat("  ", depth)+node.Location.Name)
+	})
+
+	assert.Equal(t, []string{
+		"Abyss",
+		"Toril",
+		"  Faerûn",
+		"    Sword Coast",
+		"      Baldur's Gate",
+		"      Waterdeep",
+		"        Yawning Portal",
+	}, lines)
+
+	assert.Equal(t, []string{"Abyss", "Toril"}, locationNames(tree.Roots()))
+
+	// Callers get their own copy of the roots
+	roots := tree.Roots()
+	roots[0] = nil
+	assert.Equal(t, []string{"Abyss", "Toril"}, locationNames(tree.Roots()))
+
+	node, ok := tree.Node(4)
+	if assert.True(t, ok) {
+		assert.Equal(t, "Sword Coast", node.Parent().Location.Name)
+	}
+	_, ok = tree.Node(99)
+	assert.False(t, ok)
+}
+
+func TestLocationTreeQueries(t *testing.T) {
+
+	tree := testLocationTree()
+
+	assert.Equal(t, []string{"Sword Coast", "Faerûn", "Toril"}, locationNames(tree.Ancestors(4)))
+	assert.Equal(t, []string{}, locationNames(tree.Ancestors(1)))
+	assert.Equal(t, []string{}, locationNames(tree.Ancestors(99)))
+
+	assert.Equal(t, []string{"Sword Coast", "Baldur's Gate", "Waterdeep", "Yawning Portal"}, locationNames(tree.Descendants(2)))
+	assert.Equal(t, []string{}, locationNames(tree.Descendants(5)))
+	assert.Equal(t, []string{}, locationNames(tree.Descendants(99)))
+
+	assert.Equal(t, "Toril › Faerûn › Sword Coast › Waterdeep", tree.Path(4))
+	assert.Equal(t, "Toril", tree.Path(1))
+	assert.Equal(t, "", tree.Path(99))
+}
+
+func TestLocationTreeAttach(t *testing.T) {
+
+	tree := testLocationTree()
+
+	tree.AttachCharacters([]Character{{ID: 1, Name: "Durnan", LocationID: 5}, {ID: 2, Name: "Nomad"}})
+	tree.AttachItems([]Item{{ID: 1, Name: "Blackstaff", LocationID: 4}})
+	tree.AttachFamilies([]Family{{ID: 1, Name: "Silmerhelve", LocationID: 4}})
+	tree.AttachOrganisations([]Organisation{{ID: 1, Name: "Harpers", LocationID: 2}, {ID: 2, Name: "Zhentarim", LocationID: 99}})
+
+	tavern, _ := tree.Node(5)
+	if assert.Len(t, tavern.Characters, 1) {
+		assert.Equal(t, "Durnan", tavern.Characters[0].Name)
+	}
+
+	city, _ := tree.Node(4)
+	assert.Len(t, city.Items, 1)
+	assert.Len(t, city.Families, 1)
+	assert.Empty(t, city.Characters)
+
+	continent, _ := tree.Node(2)
+	if assert.Len(t, continent.Organisations, 1) {
+		assert.Equal(t, "Harpers", continent.Organisations[0].Name)
+	}
+}
+
+func TestBuildLocationTree(t *testing.T) {
+
+	testServer, config := mockTestServer()
+	defer testServer.Close()
+	client := NewClient(config)
+	ctx := context.Background()
+
+	tree, err := client.BuildLocationTree(ctx, 1)
+
+	// The only location's parent isn't in the campaign, so it becomes a root
+	if assert.NoError(t, err) && assert.Len(t, tree.Roots(), 1) {
+		assert.Equal(t, "Mordor", tree.Path(1))
+	}
+}
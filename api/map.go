@@ -3,14 +3,13 @@ package kanka
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"time"
 )
 
 // Maps is used to query the maps endpoints
 type Maps struct {
-	client     *Client
-	campaignID int
-	urlPrefix  string
+	*EntityService[Map, MapRequest]
 }
 
 // Map is used to serialize an map object
@@ -47,6 +46,25 @@ type Map struct {
 	UpdatedBy int       `json:"updated_by"`
 }
 
+// MapRequest is used to create or update a map
+type MapRequest struct {
+	Name       string  `json:"name"`
+	Entry      *string `json:"entry,omitempty"`
+	LocationID *int    `json:"location_id,omitempty"`
+	MapID      *int    `json:"map_id,omitempty"`
+	Type       *string `json:"type,omitempty"`
+
+	Grid    *int `json:"grid,omitempty"`
+	CenterX *int `json:"center_x,omitempty"`
+	CenterY *int `json:"center_y,omitempty"`
+
+	InitialZoom *int `json:"initial_zoom,omitempty"`
+	MinZoom     *int `json:"min_zoom,omitempty"`
+	MaxZoom     *int `json:"max_zoom,omitempty"`
+
+	IsPrivate *bool `json:"is_private,omitempty"`
+}
+
 // MapMarker is used to serialize an map marker object
 type MapMarker struct {
 	ID        int  `json:"id"`
@@ -95,75 +113,168 @@ type MapGroup struct {
 	UpdatedBy int       `json:"updated_by"`
 }
 
+// MapFilter is used to filter maps in ListOptions
+type MapFilter struct {
+	LocationID int
+	MapID      int
+}
+
+// Apply implements Filter
+func (f MapFilter) Apply(q url.Values) {
+	setInt(q, "location_id", f.LocationID)
+	setInt(q, "map_id", f.MapID)
+}
+
 func init() {
 	addKnownLinkType("map", func(ctx context.Context, client *Client, campaignID int, entityID int) string {
 		m, _ := client.Maps(campaignID).GetMap(ctx, entityID)
 		return m.Name
 	})
+	addKnownSyncType("map", func(ctx context.Context, client *Client, campaignID int, opts *ListOptions) ([]SyncRecord, error) {
+		records := []SyncRecord{}
+		err := client.Maps(campaignID).Iterate(ctx, opts, func(m *Map) error {
+			records = append(records, newSyncRecord(m))
+			return nil
+		})
+		return records, err
+	})
+	addKnownEntityType("map", func(ctx context.Context, client *Client, campaignID int, id int) (Entity, error) {
+		return client.Maps(campaignID).GetMap(ctx, id)
+	})
 }
 
 // Maps returns a handle of the maps endpoint
 func (c *Client) Maps(campaignID int) *Maps {
 	return &Maps{
-		client:     c,
-		campaignID: campaignID,
-		urlPrefix:  fmt.Sprintf("/campaigns/%d/maps", campaignID),
+		EntityService: newEntityService[Map, MapRequest](c, campaignID, "maps"),
 	}
 }
 
 // GetMaps can return information about all maps
 func (m *Maps) GetMaps(ctx context.Context) (*[]Map, error) {
-
-	var err error
-	resp := []Map{}
-	url := m.urlPrefix
-
-	for len(url) > 0 && err == nil {
-		page := []Map{}
-		url, err = m.client.makeRequest(ctx, "GET", url, &page)
-		resp = append(resp, page...)
-	}
-
-	return &resp, err
+	return m.GetAll(ctx)
 }
 
 // GetMap can return information about a single map
 func (m *Maps) GetMap(ctx context.Context, id int) (*Map, error) {
+	return m.Get(ctx, id)
+}
 
-	resp := Map{}
-	_, err := m.client.makeRequest(ctx, "GET", fmt.Sprintf("%s/%d", m.urlPrefix, id), &resp)
-
-	return &resp, err
+// GetMapWithPosts can return information about a single map along with all of its posts
+func (m *Maps) GetMapWithPosts(ctx context.Context, id int) (*Map, *[]EntityPost, error) {
+	return getWithPosts(ctx, m.EntityService, id)
 }
 
 // GetMapMarkers can return information about all map markers for a given map
 func (m *Maps) GetMapMarkers(ctx context.Context, id int) (*[]MapMarker, error) {
+	return getAll[MapMarker](ctx, m.client, fmt.Sprintf("%s/%d/map_markers", m.urlPrefix, id))
+}
 
-	var err error
-	resp := []MapMarker{}
-	url := fmt.Sprintf("%s/%d/map_markers", m.urlPrefix, id)
+// GetMapGroups can return information about all map groups for a given map
+func (m *Maps) GetMapGroups(ctx context.Context, id int) (*[]MapGroup, error) {
+	return getAll[MapGroup](ctx, m.client, fmt.Sprintf("%s/%d/map_groups", m.urlPrefix, id))
+}
 
-	for len(url) > 0 && err == nil {
-		page := []MapMarker{}
-		url, err = m.client.makeRequest(ctx, "GET", url, &page)
-		resp = append(resp, page...)
-	}
+// ListMapMarkers returns a pager which fetches the map markers of a given map one page at a time
+func (m *Maps) ListMapMarkers(id int, opts *ListOptions) *MapMarkerPager {
+	return newTypedPager[MapMarker](m.client, fmt.Sprintf("%s/%d/map_markers", m.urlPrefix, id), opts)
+}
 
-	return &resp, err
+// ListMapGroups returns a pager which fetches the map groups of a given map one page at a time
+func (m *Maps) ListMapGroups(id int, opts *ListOptions) *MapGroupPager {
+	return newTypedPager[MapGroup](m.client, fmt.Sprintf("%s/%d/map_groups", m.urlPrefix, id), opts)
 }
 
-// GetMapGroups can return information about all map groups for a given map
-func (m *Maps) GetMapGroups(ctx context.Context, id int) (*[]MapGroup, error) {
+// CreateMap can create a new map and return the result
+func (m *Maps) CreateMap(ctx context.Context, mp *MapRequest) (*Map, error) {
+	return m.Create(ctx, mp)
+}
 
-	var err error
-	resp := []MapGroup{}
-	url := fmt.Sprintf("%s/%d/map_groups", m.urlPrefix, id)
+// UpdateMap can update a map and return the result
+func (m *Maps) UpdateMap(ctx context.Context, id int, mp *MapRequest) (*Map, error) {
+	return m.Update(ctx, id, mp)
+}
 
-	for len(url) > 0 && err == nil {
-		page := []MapGroup{}
-		url, err = m.client.makeRequest(ctx, "GET", url, &page)
-		resp = append(resp, page...)
-	}
+// PatchMap can update only the given fields of a map and return the result
+func (m *Maps) PatchMap(ctx context.Context, id int, fields map[string]interface{}) (*Map, error) {
+	return m.Patch(ctx, id, fields)
+}
 
-	return &resp, err
+// DeleteMap can delete a map
+func (m *Maps) DeleteMap(ctx context.Context, id int) error {
+	return m.Delete(ctx, id)
 }
+
+// GetID implements Entity
+func (m *Map) GetID() int {
+	return m.ID
+}
+
+// GetEntityID implements Entity
+func (m *Map) GetEntityID() int {
+	return m.EntityID
+}
+
+// GetName implements Entity
+func (m *Map) GetName() string {
+	return m.Name
+}
+
+// EntityType implements Entity
+func (m *Map) EntityType() string {
+	return "map"
+}
+
+// GetEntry implements Entity
+func (m *Map) GetEntry() string {
+	return m.Entry
+}
+
+// GetType implements Entity
+func (m *Map) GetType() string {
+	return m.Type
+}
+
+// GetIsPrivate implements Entity
+func (m *Map) GetIsPrivate() bool {
+	return m.IsPrivate
+}
+
+// GetImage implements Entity
+func (m *Map) GetImage() string {
+	return m.Image
+}
+
+// GetImageThumb implements Entity
+func (m *Map) GetImageThumb() string {
+	return m.ImageThumb
+}
+
+// GetCreatedAt implements Entity
+func (m *Map) GetCreatedAt() time.Time {
+	return m.CreatedAt
+}
+
+// GetCreatedBy implements Entity
+func (m *Map) GetCreatedBy() int {
+	return m.CreatedBy
+}
+
+// GetUpdatedAt implements Entity
+func (m *Map) GetUpdatedAt() time.Time {
+	return m.UpdatedAt
+}
+
+// GetUpdatedBy implements Entity
+func (m *Map) GetUpdatedBy() int {
+	return m.UpdatedBy
+}
+
+// MapPager is used to iterate over pages of maps
+type MapPager = Pager[Map]
+
+// MapMarkerPager is used to iterate over pages of map markers
+type MapMarkerPager = Pager[MapMarker]
+
+// MapGroupPager is used to iterate over pages of map groups
+type MapGroupPager = Pager[MapGroup]
@@ -186,3 +186,37 @@ func TestGetMapGroups(t *testing.T) {
 		assert.Equal(t, 1, g.UpdatedBy)
 	}
 }
+
+func TestListMapMarkers(t *testing.T) {
+
+	testServer, config := mockTestServer()
+	defer testServer.Close()
+	client := NewClient(config)
+	ctx := context.Background()
+
+	pager := client.Maps(1).ListMapMarkers(1, nil)
+
+	if assert.True(t, pager.Next(ctx)) {
+		assert.Len(t, pager.Page(), 1)
+		assert.Equal(t, 31, pager.Page()[0].ID)
+	}
+	assert.False(t, pager.Next(ctx))
+	assert.NoError(t, pager.Err())
+}
+
+func TestListMapGroups(t *testing.T) {
+
+	testServer, config := mockTestServer()
+	defer testServer.Close()
+	client := NewClient(config)
+	ctx := context.Background()
+
+	pager := client.Maps(1).ListMapGroups(1, nil)
+
+	if assert.True(t, pager.Next(ctx)) {
+		assert.Len(t, pager.Page(), 1)
+		assert.Equal(t, 3, pager.Page()[0].ID)
+	}
+	assert.False(t, pager.Next(ctx))
+	assert.NoError(t, pager.Err())
+}
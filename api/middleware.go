@@ -0,0 +1,122 @@
+package kanka
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// RequestIDHeader is the header used by RequestIDMiddleware
+const RequestIDHeader = "X-Request-ID"
+
+// Doer sends an HTTP request and returns its response. *http.Client implements Doer.
+type Doer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// DoerFunc adapts a function to the Doer interface
+type DoerFunc func(req *http.Request) (*http.Response, error)
+
+// Middleware wraps a Doer to add behaviour around every HTTP request made by the client.
+// Middleware runs after the rate-limiter, so it sees every attempt including retries.
+type Middleware func(next Doer) Doer
+
+// Logger is used by LoggingMiddleware. *log.Logger implements Logger.
+type Logger interface {
+	Printf(format string, v ...interface{})
+}
+
+// Do implements Doer
+func (f DoerFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// Use adds middleware to the config. Middleware added first is outermost, i.e. it sees requests
+// first and responses last.
+func (c *Config) Use(middleware ...Middleware) {
+	c.Middleware = append(c.Middleware, middleware...)
+}
+
+// doer returns the client's HTTP client wrapped in its middleware
+func (c *Client) doer() Doer {
+
+	var doer Doer = c.HTTPClient
+	for i := len(c.middleware) - 1; i >= 0; i-- {
+		doer = c.middleware[i](doer)
+	}
+
+	return doer
+}
+
+// LoggingMiddleware logs every request as key=value pairs, with the API token redacted
+func LoggingMiddleware(logger Logger) Middleware {
+	return func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) (*http.Response, error) {
+
+			start := time.Now()
+			resp, err := next.Do(req)
+
+			fields := []string{
+				fmt.Sprintf("method=%s", req.Method),
+				fmt.Sprintf("url=%q", req.URL.String()),
+				fmt.Sprintf("authorization=%q", redactAuthorization(req.Header.Get("Authorization"))),
+			}
+			if id := req.Header.Get(RequestIDHeader); id != "" {
+				fields = append(fields, fmt.Sprintf("request_id=%s", id))
+			}
+			if resp != nil {
+				fields = append(fields, fmt.Sprintf("status=%d", resp.StatusCode))
+			}
+			fields = append(fields, fmt.Sprintf("duration=%s", time.Since(start)))
+			if err != nil {
+				fields = append(fields, fmt.Sprintf("error=%q", err.Error()))
+			}
+
+			logger.Printf("kanka request %s", strings.Join(fields, " "))
+			return resp, err
+		})
+	}
+}
+
+// UserAgentMiddleware sets the User-Agent header of every request
+func UserAgentMiddleware(userAgent string) Middleware {
+	return func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) (*http.Response, error) {
+			req.Header.Set("User-Agent", userAgent)
+			return next.Do(req)
+		})
+	}
+}
+
+// RequestIDMiddleware sets a random X-Request-ID header on every request which doesn't already have one
+func RequestIDMiddleware() Middleware {
+	return func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) (*http.Response, error) {
+			if req.Header.Get(RequestIDHeader) == "" {
+				id := make([]byte, 16)
+				if _, err := rand.Read(id); err != nil {
+					return nil, err
+				}
+				req.Header.Set(RequestIDHeader, hex.EncodeToString(id))
+			}
+			return next.Do(req)
+		})
+	}
+}
+
+// redactAuthorization hides the token in an Authorization header while keeping its scheme
+func redactAuthorization(header string) string {
+
+	if header == "" {
+		return ""
+	}
+
+	if scheme := strings.SplitN(header, " ", 2); len(scheme) == 2 {
+		return scheme[0] + " [REDACTED]"
+	}
+
+	return "[REDACTED]"
+}
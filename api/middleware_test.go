@@ -0,0 +1,154 @@
+package kanka
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMiddlewareOrder(t *testing.T) {
+
+	testServer, config := mockTestServer()
+	defer func() { testServer.Close() }()
+
+	// Middleware added first is outermost
+	order := []string{}
+	tracer := func(name string) Middleware {
+		return func(next Doer) Doer {
+			return DoerFunc(func(req *http.Request) (*http.Response, error) {
+				order = append(order, name+" request")
+				resp, err := next.Do(req)
+				order = append(order, name+" response")
+				return resp, err
+			})
+		}
+	}
+	config.Use(tracer("first"), tracer("second"))
+
+	client := NewClient(config)
+	_, err := client.makeRequest(context.Background(), "GET", "/campaigns", nil)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{"first request", "second request", "second response", "first response"}, order)
+}
+
+func TestMiddlewareRetries(t *testing.T) {
+
+	attempts := 0
+	testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		attempts++
+		if attempts == 1 {
+			res.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		res.Header().Set("Content-Type", "application/json")
+		res.WriteHeader(200)
+		_, err := res.Write([]byte("{}"))
+		assert.NoError(t, err)
+	}))
+	defer func() { testServer.Close() }()
+
+	config := DefaultConfig()
+	config.BaseURL = testServer.URL
+	config.ForceTLS = false
+	config.Retry.InitialBackoff = 0
+
+	// Middleware sees every attempt
+	seen := 0
+	config.Use(func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) (*http.Response, error) {
+			seen++
+			return next.Do(req)
+		})
+	})
+
+	client := NewClient(config)
+	_, err := client.makeRequest(context.Background(), "GET", "/", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, seen)
+}
+
+func TestMiddlewareShortCircuit(t *testing.T) {
+
+	config := DefaultConfig()
+	config.BaseURL = "http://kanka.test"
+	config.ForceTLS = false
+	config.Retry = nil
+
+	// Middleware can answer without calling the HTTP client, leaving the response without a Request
+	config.Use(func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: http.StatusServiceUnavailable,
+				Status:     "503 Service Unavailable",
+				Header:     http.Header{},
+				Body:       io.NopCloser(strings.NewReader("")),
+			}, nil
+		})
+	})
+
+	client := NewClient(config)
+	_, err := client.Characters(1).GetCharacter(context.Background(), 1)
+
+	var apiErr *APIError
+	if assert.ErrorAs(t, err, &apiErr) {
+		assert.Equal(t, http.StatusServiceUnavailable, apiErr.StatusCode)
+		assert.Equal(t, "GET", apiErr.Method)
+		assert.Equal(t, "http://kanka.test/campaigns/1/characters/1", apiErr.URL)
+	}
+}
+
+func TestBuiltinMiddleware(t *testing.T) {
+
+	var userAgent, requestID string
+	testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		userAgent = req.Header.Get("User-Agent")
+		requestID = req.Header.Get(RequestIDHeader)
+		res.Header().Set("Content-Type", "application/json")
+		res.WriteHeader(200)
+		_, err := res.Write([]byte("{}"))
+		assert.NoError(t, err)
+	}))
+	defer func() { testServer.Close() }()
+
+	config := DefaultConfig()
+	config.BaseURL = testServer.URL
+	config.ForceTLS = false
+	config.Token = "secret-token"
+
+	var logged bytes.Buffer
+	config.Use(
+		UserAgentMiddleware("kanka-test/1.0"),
+		RequestIDMiddleware(),
+		LoggingMiddleware(log.New(&logged, "", 0)),
+	)
+
+	client := NewClient(config)
+	_, err := client.makeRequest(context.Background(), "GET", "/campaigns", nil)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "kanka-test/1.0", userAgent)
+	assert.Len(t, requestID, 32)
+
+	// The log line includes the request ID, but never the token
+	line := logged.String()
+	assert.True(t, strings.HasPrefix(line, "kanka request method=GET "))
+	assert.Contains(t, line, `url="`+testServer.URL+`/campaigns"`)
+	assert.Contains(t, line, `authorization="Bearer [REDACTED]"`)
+	assert.Contains(t, line, "request_id="+requestID)
+	assert.Contains(t, line, "status=200")
+	assert.NotContains(t, line, "secret-token")
+}
+
+func TestRedactAuthorization(t *testing.T) {
+	assert.Equal(t, "", redactAuthorization(""))
+	assert.Equal(t, "Bearer [REDACTED]", redactAuthorization("Bearer abc"))
+	assert.Equal(t, "[REDACTED]", redactAuthorization("abc"))
+}
@@ -2,15 +2,13 @@ package kanka
 
 import (
 	"context"
-	"fmt"
+	"net/url"
 	"time"
 )
 
 // Notes is used to query the notes endpoints
 type Notes struct {
-	client     *Client
-	campaignID int
-	urlPrefix  string
+	*EntityService[Note, NoteRequest]
 }
 
 // Note is used to serialize a note object
@@ -36,42 +34,151 @@ type Note struct {
 	UpdatedBy int       `json:"updated_by"`
 }
 
+// NoteRequest is used to create or update a note
+type NoteRequest struct {
+	Name     string  `json:"name"`
+	Entry    *string `json:"entry,omitempty"`
+	NoteID   *int    `json:"note_id,omitempty"`
+	IsPinned *int    `json:"is_pinned,omitempty"`
+	Type     *string `json:"type,omitempty"`
+
+	IsPrivate *bool `json:"is_private,omitempty"`
+}
+
+// NoteFilter is used to filter notes in ListOptions
+type NoteFilter struct {
+	NoteID int
+}
+
+// Apply implements Filter
+func (f NoteFilter) Apply(q url.Values) {
+	setInt(q, "note_id", f.NoteID)
+}
+
 func init() {
 	addKnownLinkType("note", func(ctx context.Context, client *Client, campaignID int, entityID int) string {
 		note, _ := client.Notes(campaignID).GetNote(ctx, entityID)
 		return note.Name
 	})
+	addKnownSyncType("note", func(ctx context.Context, client *Client, campaignID int, opts *ListOptions) ([]SyncRecord, error) {
+		records := []SyncRecord{}
+		err := client.Notes(campaignID).Iterate(ctx, opts, func(note *Note) error {
+			records = append(records, newSyncRecord(note))
+			return nil
+		})
+		return records, err
+	})
+	addKnownEntityType("note", func(ctx context.Context, client *Client, campaignID int, id int) (Entity, error) {
+		return client.Notes(campaignID).GetNote(ctx, id)
+	})
 }
 
 // Notes returns a handle of the notes endpoint
 func (c *Client) Notes(campaignID int) *Notes {
 	return &Notes{
-		client:     c,
-		campaignID: campaignID,
-		urlPrefix:  fmt.Sprintf("/campaigns/%d/notes", campaignID),
+		EntityService: newEntityService[Note, NoteRequest](c, campaignID, "notes"),
 	}
 }
 
 // GetNotes can return information about all notes
 func (n *Notes) GetNotes(ctx context.Context) (*[]Note, error) {
+	return n.GetAll(ctx)
+}
+
+// GetNote can return information about a single note
+func (n *Notes) GetNote(ctx context.Context, id int) (*Note, error) {
+	return n.Get(ctx, id)
+}
 
-	var err error
-	resp := []Note{}
-	url := n.urlPrefix
+// GetNoteWithPosts can return information about a single note along with all of its posts
+func (n *Notes) GetNoteWithPosts(ctx context.Context, id int) (*Note, *[]EntityPost, error) {
+	return getWithPosts(ctx, n.EntityService, id)
+}
 
-	for len(url) > 0 && err == nil {
-		page := []Note{}
-		url, err = n.client.makeRequest(ctx, "GET", url, &page)
-		resp = append(resp, page...)
-	}
+// CreateNote can create a new note and return the result
+func (n *Notes) CreateNote(ctx context.Context, note *NoteRequest) (*Note, error) {
+	return n.Create(ctx, note)
+}
 
-	return &resp, err
+// UpdateNote can update a note and return the result
+func (n *Notes) UpdateNote(ctx context.Context, id int, note *NoteRequest) (*Note, error) {
+	return n.Update(ctx, id, note)
 }
 
-// GetNote can return information about a single note
-func (n *Notes) GetNote(ctx context.Context, id int) (*Note, error) {
+// PatchNote can update only the given fields of a note and return the result
+func (n *Notes) PatchNote(ctx context.Context, id int, fields map[string]interface{}) (*Note, error) {
+	return n.Patch(ctx, id, fields)
+}
+
+// DeleteNote can delete a note
+func (n *Notes) DeleteNote(ctx context.Context, id int) error {
+	return n.Delete(ctx, id)
+}
+
+// GetID implements Entity
+func (n *Note) GetID() int {
+	return n.ID
+}
 
-	resp := Note{}
-	_, err := n.client.makeRequest(ctx, "GET", fmt.Sprintf("%s/%d", n.urlPrefix, id), &resp)
-	return &resp, err
+// GetEntityID implements Entity
+func (n *Note) GetEntityID() int {
+	return n.EntityID
 }
+
+// GetName implements Entity
+func (n *Note) GetName() string {
+	return n.Name
+}
+
+// EntityType implements Entity
+func (n *Note) EntityType() string {
+	return "note"
+}
+
+// GetEntry implements Entity
+func (n *Note) GetEntry() string {
+	return n.Entry
+}
+
+// GetType implements Entity
+func (n *Note) GetType() string {
+	return n.Type
+}
+
+// GetIsPrivate implements Entity
+func (n *Note) GetIsPrivate() bool {
+	return n.IsPrivate
+}
+
+// GetImage implements Entity
+func (n *Note) GetImage() string {
+	return n.Image
+}
+
+// GetImageThumb implements Entity
+func (n *Note) GetImageThumb() string {
+	return n.ImageThumb
+}
+
+// GetCreatedAt implements Entity
+func (n *Note) GetCreatedAt() time.Time {
+	return n.CreatedAt
+}
+
+// GetCreatedBy implements Entity
+func (n *Note) GetCreatedBy() int {
+	return n.CreatedBy
+}
+
+// GetUpdatedAt implements Entity
+func (n *Note) GetUpdatedAt() time.Time {
+	return n.UpdatedAt
+}
+
+// GetUpdatedBy implements Entity
+func (n *Note) GetUpdatedBy() int {
+	return n.UpdatedBy
+}
+
+// NotePager is used to iterate over pages of notes
+type NotePager = Pager[Note]
@@ -2,15 +2,13 @@ package kanka
 
 import (
 	"context"
-	"fmt"
+	"net/url"
 	"time"
 )
 
 // Organisations is used to query the organisations endpoints
 type Organisations struct {
-	client     *Client
-	campaignID int
-	urlPrefix  string
+	*EntityService[Organisation, OrganisationRequest]
 }
 
 // Organisation is used to serialize an organisation object
@@ -37,42 +35,153 @@ type Organisation struct {
 	UpdatedBy int       `json:"updated_by"`
 }
 
+// OrganisationRequest is used to create or update an organisation
+type OrganisationRequest struct {
+	Name           string  `json:"name"`
+	Entry          *string `json:"entry,omitempty"`
+	LocationID     *int    `json:"location_id,omitempty"`
+	OrganisationID *int    `json:"organisation_id,omitempty"`
+	Type           *string `json:"type,omitempty"`
+
+	IsPrivate *bool `json:"is_private,omitempty"`
+}
+
+// OrganisationFilter is used to filter organisations in ListOptions
+type OrganisationFilter struct {
+	LocationID     int
+	OrganisationID int
+}
+
+// Apply implements Filter
+func (f OrganisationFilter) Apply(q url.Values) {
+	setInt(q, "location_id", f.LocationID)
+	setInt(q, "organisation_id", f.OrganisationID)
+}
+
 func init() {
 	addKnownLinkType("organisation", func(ctx context.Context, client *Client, campaignID int, entityID int) string {
 		organisation, _ := client.Organisations(campaignID).GetOrganisation(ctx, entityID)
 		return organisation.Name
 	})
+	addKnownSyncType("organisation", func(ctx context.Context, client *Client, campaignID int, opts *ListOptions) ([]SyncRecord, error) {
+		records := []SyncRecord{}
+		err := client.Organisations(campaignID).Iterate(ctx, opts, func(organisation *Organisation) error {
+			records = append(records, newSyncRecord(organisation))
+			return nil
+		})
+		return records, err
+	})
+	addKnownEntityType("organisation", func(ctx context.Context, client *Client, campaignID int, id int) (Entity, error) {
+		return client.Organisations(campaignID).GetOrganisation(ctx, id)
+	})
 }
 
 // Organisations returns a handle of the organisations endpoint
 func (c *Client) Organisations(campaignID int) *Organisations {
 	return &Organisations{
-		client:     c,
-		campaignID: campaignID,
-		urlPrefix:  fmt.Sprintf("/campaigns/%d/organisations", campaignID),
+		EntityService: newEntityService[Organisation, OrganisationRequest](c, campaignID, "organisations"),
 	}
 }
 
 // GetOrganisations can return information about all organisations
 func (o *Organisations) GetOrganisations(ctx context.Context) (*[]Organisation, error) {
+	return o.GetAll(ctx)
+}
+
+// GetOrganisation can return information about a single organisation
+func (o *Organisations) GetOrganisation(ctx context.Context, id int) (*Organisation, error) {
+	return o.Get(ctx, id)
+}
 
-	var err error
-	resp := []Organisation{}
-	url := o.urlPrefix
+// GetOrganisationWithPosts can return information about a single organisation along with all of its posts
+func (o *Organisations) GetOrganisationWithPosts(ctx context.Context, id int) (*Organisation, *[]EntityPost, error) {
+	return getWithPosts(ctx, o.EntityService, id)
+}
 
-	for len(url) > 0 && err == nil {
-		page := []Organisation{}
-		url, err = o.client.makeRequest(ctx, "GET", url, &page)
-		resp = append(resp, page...)
-	}
+// CreateOrganisation can create a new organisation and return the result
+func (o *Organisations) CreateOrganisation(ctx context.Context, organisation *OrganisationRequest) (*Organisation, error) {
+	return o.Create(ctx, organisation)
+}
 
-	return &resp, err
+// UpdateOrganisation can update an organisation and return the result
+func (o *Organisations) UpdateOrganisation(ctx context.Context, id int, organisation *OrganisationRequest) (*Organisation, error) {
+	return o.Update(ctx, id, organisation)
 }
 
-// GetOrganisation can return information about a single organisation
-func (o *Organisations) GetOrganisation(ctx context.Context, id int) (*Organisation, error) {
+// PatchOrganisation can update only the given fields of an organisation and return the result
+func (o *Organisations) PatchOrganisation(ctx context.Context, id int, fields map[string]interface{}) (*Organisation, error) {
+	return o.Patch(ctx, id, fields)
+}
+
+// DeleteOrganisation can delete an organisation
+func (o *Organisations) DeleteOrganisation(ctx context.Context, id int) error {
+	return o.Delete(ctx, id)
+}
+
+// GetID implements Entity
+func (o *Organisation) GetID() int {
+	return o.ID
+}
 
-	resp := Organisation{}
-	_, err := o.client.makeRequest(ctx, "GET", fmt.Sprintf("%s/%d", o.urlPrefix, id), &resp)
-	return &resp, err
+// GetEntityID implements Entity
+func (o *Organisation) GetEntityID() int {
+	return o.EntityID
 }
+
+// GetName implements Entity
+func (o *Organisation) GetName() string {
+	return o.Name
+}
+
+// EntityType implements Entity
+func (o *Organisation) EntityType() string {
+	return "organisation"
+}
+
+// GetEntry implements Entity
+func (o *Organisation) GetEntry() string {
+	return o.Entry
+}
+
+// GetType implements Entity
+func (o *Organisation) GetType() string {
+	return o.Type
+}
+
+// GetIsPrivate implements Entity
+func (o *Organisation) GetIsPrivate() bool {
+	return o.IsPrivate
+}
+
+// GetImage implements Entity
+func (o *Organisation) GetImage() string {
+	return o.Image
+}
+
+// GetImageThumb implements Entity
+func (o *Organisation) GetImageThumb() string {
+	return o.ImageThumb
+}
+
+// GetCreatedAt implements Entity
+func (o *Organisation) GetCreatedAt() time.Time {
+	return o.CreatedAt
+}
+
+// GetCreatedBy implements Entity
+func (o *Organisation) GetCreatedBy() int {
+	return o.CreatedBy
+}
+
+// GetUpdatedAt implements Entity
+func (o *Organisation) GetUpdatedAt() time.Time {
+	return o.UpdatedAt
+}
+
+// GetUpdatedBy implements Entity
+func (o *Organisation) GetUpdatedBy() int {
+	return o.UpdatedBy
+}
+
+// OrganisationPager is used to iterate over pages of organisations
+type OrganisationPager = Pager[Organisation]
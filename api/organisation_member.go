@@ -0,0 +1,133 @@
+package kanka
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Organisation member pins, as found in OrganisationMember.PinID.
+// Pinned members are shown on the character's or the organisation's page.
+const (
+	MemberPinCharacter    = 1
+	MemberPinOrganisation = 2
+	MemberPinBoth         = 3
+)
+
+// Organisation member statuses, as found in OrganisationMember.StatusID
+const (
+	MemberStatusActive   = 0
+	MemberStatusInactive = 1
+	MemberStatusUnknown  = 2
+)
+
+// OrganisationMember is used to serialize an organisation member object, which makes a character a member of an organisation
+type OrganisationMember struct {
+	ID             int  `json:"id"`
+	OrganisationID int  `json:"organisation_id"`
+	CharacterID    int  `json:"character_id"`
+	IsPrivate      bool `json:"is_private"`
+
+	Role     string `json:"role"`
+	PinID    int    `json:"pin_id"`
+	StatusID int    `json:"status_id"`
+
+	// ParentID is the ID of the member this member reports to, if any
+	ParentID int `json:"parent_id"`
+
+	CreatedAt time.Time `json:"created_at"`
+	CreatedBy int       `json:"created_by"`
+	UpdatedAt time.Time `json:"updated_at"`
+	UpdatedBy int       `json:"updated_by"`
+}
+
+// OrganisationMemberRequest is used to create or update an organisation member
+type OrganisationMemberRequest struct {
+	OrganisationID int     `json:"organisation_id"`
+	CharacterID    int     `json:"character_id"`
+	Role           *string `json:"role,omitempty"`
+	PinID          *int    `json:"pin_id,omitempty"`
+	StatusID       *int    `json:"status_id,omitempty"`
+	ParentID       *int    `json:"parent_id,omitempty"`
+
+	IsPrivate *bool `json:"is_private,omitempty"`
+}
+
+// OrganisationMembership is an organisation member joined with its character.
+// Character is nil if the character couldn't be fetched.
+type OrganisationMembership struct {
+	OrganisationMember
+	Character *Character
+}
+
+// CharacterMembership is an organisation member joined with its organisation.
+// Organisation is nil if the organisation couldn't be fetched.
+type CharacterMembership struct {
+	OrganisationMember
+	Organisation *Organisation
+}
+
+// GetOrganisationMembers can return information about all members of a given organisation
+func (o *Organisations) GetOrganisationMembers(ctx context.Context, id int) (*[]OrganisationMember, error) {
+	return organisationMembers(o, id).GetAll(ctx)
+}
+
+// CreateOrganisationMember can add a member to a given organisation and return the result
+func (o *Organisations) CreateOrganisationMember(ctx context.Context, id int, member *OrganisationMemberRequest) (*OrganisationMember, error) {
+	return organisationMembers(o, id).Create(ctx, member)
+}
+
+// UpdateOrganisationMember can update a member of a given organisation and return the result
+func (o *Organisations) UpdateOrganisationMember(ctx context.Context, id int, memberID int, member *OrganisationMemberRequest) (*OrganisationMember, error) {
+	return organisationMembers(o, id).Update(ctx, memberID, member)
+}
+
+// PatchOrganisationMember can update only the given fields of a member of a given organisation and return the result
+func (o *Organisations) PatchOrganisationMember(ctx context.Context, id int, memberID int, fields map[string]interface{}) (*OrganisationMember, error) {
+	return organisationMembers(o, id).Patch(ctx, memberID, fields)
+}
+
+// DeleteOrganisationMember can remove a member from a given organisation
+func (o *Organisations) DeleteOrganisationMember(ctx context.Context, id int, memberID int) error {
+	return organisationMembers(o, id).Delete(ctx, memberID)
+}
+
+// GetMembers can return the members of a given organisation joined with their characters.
+// Each character is fetched once, concurrently. Members whose character can't be fetched have a nil
+// Character, and are returned along with the error for the lowest failed character ID.
+func (o *Organisations) GetMembers(ctx context.Context, id int) ([]OrganisationMembership, error) {
+
+	members, err := o.GetOrganisationMembers(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return joinMany(ctx, o.client.Characters(o.campaignID).EntityService, "character", *members,
+		func(member OrganisationMember) int { return member.CharacterID },
+		func(member OrganisationMember, character *Character) OrganisationMembership {
+			return OrganisationMembership{OrganisationMember: member, Character: character}
+		})
+}
+
+// GetOrganisations can return the memberships of a given character joined with their organisations,
+// using the character's own list of organisations rather than searching every organisation.
+// Memberships whose organisation can't be fetched have a nil Organisation, and are returned along with
+// the error for the lowest failed organisation ID.
+func (c *Characters) GetOrganisations(ctx context.Context, id int) ([]CharacterMembership, error) {
+
+	members, err := getAll[OrganisationMember](ctx, c.client, fmt.Sprintf("%s/%d/organisations", c.urlPrefix, id))
+	if err != nil {
+		return nil, err
+	}
+
+	return joinMany(ctx, c.client.Organisations(c.campaignID).EntityService, "organisation", *members,
+		func(member OrganisationMember) int { return member.OrganisationID },
+		func(member OrganisationMember, organisation *Organisation) CharacterMembership {
+			return CharacterMembership{OrganisationMember: member, Organisation: organisation}
+		})
+}
+
+// organisationMembers returns a service for the members of a given organisation
+func organisationMembers(o *Organisations, id int) *EntityService[OrganisationMember, OrganisationMemberRequest] {
+	return newEntityService[OrganisationMember, OrganisationMemberRequest](o.client, o.campaignID, fmt.Sprintf("organisations/%d/organisation_members", id))
+}
@@ -0,0 +1,116 @@
+package kanka
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetOrganisationMembers(t *testing.T) {
+
+	testServer, config := mockTestServer()
+	defer testServer.Close()
+	client := NewClient(config)
+	ctx := context.Background()
+
+	members, err := client.Organisations(1).GetOrganisationMembers(ctx, 1)
+
+	if assert.NoError(t, err) {
+		assert.Len(t, *members, 2)
+
+		m := (*members)[0]
+		assert.Equal(t, 1, m.ID)
+		assert.Equal(t, 1, m.OrganisationID)
+		assert.Equal(t, 1, m.CharacterID)
+		assert.Equal(t, "Cult leader", m.Role)
+		assert.Equal(t, MemberPinBoth, m.PinID)
+		assert.Equal(t, MemberStatusActive, m.StatusID)
+		assert.Equal(t, false, m.IsPrivate)
+
+		// Members can report to other members
+		m = (*members)[1]
+		assert.Equal(t, MemberStatusUnknown, m.StatusID)
+		assert.Equal(t, true, m.IsPrivate)
+		assert.Equal(t, 1, m.ParentID)
+
+		// Date & time assertions
+		created := time.Date(2019, time.January, 30, 0, 1, 44, 0, time.UTC)
+		updated := time.Date(2019, time.August, 29, 13, 48, 54, 0, time.UTC)
+		assert.Equal(t, created, m.CreatedAt)
+		assert.Equal(t, updated, m.UpdatedAt)
+	}
+}
+
+func TestWriteOrganisationMembers(t *testing.T) {
+
+	testServer, config, requests := recordingTestServer()
+	defer testServer.Close()
+	client := NewClient(config)
+	ctx := context.Background()
+	organisations := client.Organisations(1)
+
+	_, err := organisations.CreateOrganisationMember(ctx, 1, &OrganisationMemberRequest{OrganisationID: 1, CharacterID: 1, Role: String("Acolyte")})
+	assert.NoError(t, err)
+	assert.Equal(t, recordedRequest{Method: "POST", Path: "/campaigns/1/organisations/1/organisation_members", Body: `{"organisation_id":1,"character_id":1,"role":"Acolyte"}`}, requests.last())
+
+	_, err = organisations.UpdateOrganisationMember(ctx, 1, 1, &OrganisationMemberRequest{OrganisationID: 1, CharacterID: 1, StatusID: Int(MemberStatusActive)})
+	assert.NoError(t, err)
+	assert.Equal(t, recordedRequest{Method: "PUT", Path: "/campaigns/1/organisations/1/organisation_members/1", Body: `{"organisation_id":1,"character_id":1,"status_id":0}`}, requests.last())
+
+	_, err = organisations.PatchOrganisationMember(ctx, 1, 1, map[string]interface{}{"status_id": MemberStatusInactive})
+	assert.NoError(t, err)
+	assert.Equal(t, recordedRequest{Method: "PATCH", Path: "/campaigns/1/organisations/1/organisation_members/1", Body: `{"status_id":1}`}, requests.last())
+
+	assert.NoError(t, organisations.DeleteOrganisationMember(ctx, 1, 1))
+	assert.Equal(t, recordedRequest{Method: "DELETE", Path: "/campaigns/1/organisations/1/organisation_members/1"}, requests.last())
+}
+
+func TestGetMembers(t *testing.T) {
+
+	testServer, config := mockTestServer()
+	defer testServer.Close()
+	config.Retry = nil
+	client := NewClient(config)
+	ctx := context.Background()
+
+	// Missing characters are reported, but the rest of the members are still returned
+	members, err := client.Organisations(1).GetMembers(ctx, 1)
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.EqualError(t, err, "fetching character 404: Non-2xx response: 404 Not Found")
+
+	if assert.Len(t, members, 2) {
+		assert.Equal(t, "Cult leader", members[0].Role)
+		if assert.NotNil(t, members[0].Character) {
+			assert.Equal(t, "Jonathan Green", members[0].Character.Name)
+		}
+		assert.Nil(t, members[1].Character)
+	}
+
+	_, err = client.Organisations(1).GetMembers(ctx, 404)
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestGetCharacterOrganisations(t *testing.T) {
+
+	testServer, config := mockTestServer()
+	defer testServer.Close()
+	config.Retry = nil
+	client := NewClient(config)
+	ctx := context.Background()
+
+	memberships, err := client.Characters(1).GetOrganisations(ctx, 1)
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.EqualError(t, err, "fetching organisation 404: Non-2xx response: 404 Not Found")
+
+	if assert.Len(t, memberships, 2) {
+		if assert.NotNil(t, memberships[0].Organisation) {
+			assert.Equal(t, "Tiamat Cultists", memberships[0].Organisation.Name)
+		}
+		assert.Equal(t, "Cult leader", memberships[0].Role)
+
+		assert.Nil(t, memberships[1].Organisation)
+		assert.Equal(t, MemberStatusInactive, memberships[1].StatusID)
+	}
+}
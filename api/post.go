@@ -0,0 +1,112 @@
+package kanka
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Post visibilities, as found in EntityPost.Visibility
+const (
+	VisibilityAll       = "all"
+	VisibilityAdmin     = "admin"
+	VisibilityAdminSelf = "admin-self"
+	VisibilitySelf      = "self"
+	VisibilityMembers   = "members"
+)
+
+// EntityPosts is used to query the posts endpoints of an entity.
+// Posts are the long-form notes attached to an entity, which older versions of Kanka called entity notes.
+type EntityPosts struct {
+	*EntityService[EntityPost, EntityPostRequest]
+}
+
+// EntityPost is used to serialize an entity post object
+type EntityPost struct {
+	ID        int  `json:"id"`
+	EntityID  int  `json:"entity_id"`
+	IsPrivate bool `json:"is_private"`
+	IsPinned  bool `json:"is_pinned"`
+
+	Name        string `json:"name"`
+	Entry       string `json:"entry"`
+	EntryParsed string `json:"entry_parsed"`
+
+	Visibility   string `json:"visibility"`
+	VisibilityID int    `json:"visibility_id"`
+	Position     int    `json:"position"`
+	LayoutID     int    `json:"layout_id"`
+
+	CreatedAt time.Time `json:"created_at"`
+	CreatedBy int       `json:"created_by"`
+	UpdatedAt time.Time `json:"updated_at"`
+	UpdatedBy int       `json:"updated_by"`
+}
+
+// EntityPostRequest is used to create or update a post
+type EntityPostRequest struct {
+	Name     string  `json:"name"`
+	Entry    *string `json:"entry,omitempty"`
+	IsPinned *bool   `json:"is_pinned,omitempty"`
+
+	Visibility   *string `json:"visibility,omitempty"`
+	VisibilityID *int    `json:"visibility_id,omitempty"`
+	Position     *int    `json:"position,omitempty"`
+	LayoutID     *int    `json:"layout_id,omitempty"`
+
+	IsPrivate *bool `json:"is_private,omitempty"`
+}
+
+// EntityPosts returns a handle on the posts endpoints of an entity
+func (c *Client) EntityPosts(campaignID int, entityID int) *EntityPosts {
+	return &EntityPosts{
+		EntityService: newEntityService[EntityPost, EntityPostRequest](c, campaignID, fmt.Sprintf("entities/%d/posts", entityID)),
+	}
+}
+
+// GetEntityPosts can return information about all posts of the entity
+func (p *EntityPosts) GetEntityPosts(ctx context.Context) (*[]EntityPost, error) {
+	return p.GetAll(ctx)
+}
+
+// GetEntityPost can return information about a single post
+func (p *EntityPosts) GetEntityPost(ctx context.Context, id int) (*EntityPost, error) {
+	return p.Get(ctx, id)
+}
+
+// CreateEntityPost can create a new post and return the result
+func (p *EntityPosts) CreateEntityPost(ctx context.Context, post *EntityPostRequest) (*EntityPost, error) {
+	return p.Create(ctx, post)
+}
+
+// UpdateEntityPost can update a post and return the result
+func (p *EntityPosts) UpdateEntityPost(ctx context.Context, id int, post *EntityPostRequest) (*EntityPost, error) {
+	return p.Update(ctx, id, post)
+}
+
+// PatchEntityPost can update only the given fields of a post and return the result
+func (p *EntityPosts) PatchEntityPost(ctx context.Context, id int, fields map[string]interface{}) (*EntityPost, error) {
+	return p.Patch(ctx, id, fields)
+}
+
+// DeleteEntityPost can delete a post
+func (p *EntityPosts) DeleteEntityPost(ctx context.Context, id int) error {
+	return p.Delete(ctx, id)
+}
+
+// getWithPosts returns a single entity along with all of its posts, for the WithPosts methods of the
+// entity handles (e.g. Characters.GetCharacterWithPosts). Only services of entity types can be passed,
+// as other objects have no posts.
+func getWithPosts[T any, R any, PT interface {
+	*T
+	Entity
+}](ctx context.Context, s *EntityService[T, R], id int) (*T, *[]EntityPost, error) {
+
+	obj, err := s.Get(ctx, id)
+	if err != nil {
+		return obj, nil, err
+	}
+
+	posts, err := s.client.EntityPosts(s.campaignID, PT(obj).GetEntityID()).GetEntityPosts(ctx)
+	return obj, posts, err
+}
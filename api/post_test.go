@@ -0,0 +1,96 @@
+package kanka
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEntityPosts(t *testing.T) {
+
+	client := NewClient(DefaultConfig())
+	p := client.EntityPosts(1, 4)
+
+	assert.Equal(t, client, p.client)
+	assert.Equal(t, "/campaigns/1/entities/4/posts", p.urlPrefix)
+}
+
+func TestGetEntityPosts(t *testing.T) {
+
+	testServer, config := mockTestServer()
+	defer testServer.Close()
+	client := NewClient(config)
+	ctx := context.Background()
+
+	posts, err := client.EntityPosts(1, 4).GetEntityPosts(ctx)
+
+	if assert.NoError(t, err) {
+		assert.Len(t, *posts, 2)
+
+		p := (*posts)[0]
+		assert.Equal(t, 1, p.ID)
+		assert.Equal(t, 4, p.EntityID)
+		assert.Equal(t, "Backstory", p.Name)
+		assert.Equal(t, "\n<p>Lorem Ipsum.</p>\n", p.Entry)
+		assert.Equal(t, VisibilityAll, p.Visibility)
+		assert.Equal(t, 1, p.Position)
+		assert.Equal(t, true, p.IsPinned)
+
+		assert.Equal(t, VisibilityAdmin, (*posts)[1].Visibility)
+	}
+}
+
+func TestGetEntityPost(t *testing.T) {
+
+	testServer, config := mockTestServer()
+	defer testServer.Close()
+	client := NewClient(config)
+	ctx := context.Background()
+
+	post, err := client.EntityPosts(1, 4).GetEntityPost(ctx, 1)
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, 1, post.ID)
+		assert.Equal(t, "Backstory", post.Name)
+	}
+}
+
+func TestWriteEntityPosts(t *testing.T) {
+
+	testServer, config, requests := recordingTestServer()
+	defer testServer.Close()
+	client := NewClient(config)
+	ctx := context.Background()
+	posts := client.EntityPosts(1, 4)
+
+	_, err := posts.CreateEntityPost(ctx, &EntityPostRequest{Name: "Rumours", Visibility: String(VisibilityMembers), Position: Int(3)})
+	assert.NoError(t, err)
+	assert.Equal(t, recordedRequest{Method: "POST", Path: "/campaigns/1/entities/4/posts", Body: `{"name":"Rumours","visibility":"members","position":3}`}, requests.last())
+
+	_, err = posts.UpdateEntityPost(ctx, 1, &EntityPostRequest{Name: "History", Entry: String(""), IsPinned: Bool(false)})
+	assert.NoError(t, err)
+	assert.Equal(t, recordedRequest{Method: "PUT", Path: "/campaigns/1/entities/4/posts/1", Body: `{"name":"History","entry":"","is_pinned":false}`}, requests.last())
+
+	_, err = posts.PatchEntityPost(ctx, 1, map[string]interface{}{"position": 5})
+	assert.NoError(t, err)
+	assert.Equal(t, recordedRequest{Method: "PATCH", Path: "/campaigns/1/entities/4/posts/1", Body: `{"position":5}`}, requests.last())
+
+	assert.NoError(t, posts.DeleteEntityPost(ctx, 1))
+	assert.Equal(t, recordedRequest{Method: "DELETE", Path: "/campaigns/1/entities/4/posts/1"}, requests.last())
+}
+
+func TestGetWithPosts(t *testing.T) {
+
+	testServer, config := mockTestServer()
+	defer testServer.Close()
+	client := NewClient(config)
+	ctx := context.Background()
+
+	character, posts, err := client.Characters(1).GetCharacterWithPosts(ctx, 1)
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, "Jonathan Green", character.Name)
+		assert.Len(t, *posts, 2)
+	}
+}
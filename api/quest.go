@@ -3,14 +3,13 @@ package kanka
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"time"
 )
 
 // Quests is used to query the quests endpoints
 type Quests struct {
-	client     *Client
-	campaignID int
-	urlPrefix  string
+	*EntityService[Quest, QuestRequest]
 }
 
 // Quest is used to serialize an quest object
@@ -40,6 +39,19 @@ type Quest struct {
 	UpdatedBy int       `json:"updated_by"`
 }
 
+// QuestRequest is used to create or update a quest
+type QuestRequest struct {
+	Name        string  `json:"name"`
+	Entry       *string `json:"entry,omitempty"`
+	CharacterID *int    `json:"character_id,omitempty"`
+	Date        *string `json:"date,omitempty"`
+	IsCompleted *bool   `json:"is_completed,omitempty"`
+	QuestID     *int    `json:"quest_id,omitempty"`
+	Type        *string `json:"type,omitempty"`
+
+	IsPrivate *bool `json:"is_private,omitempty"`
+}
+
 // QuestCharacter is used to serialize a quest character object
 type QuestCharacter struct {
 	ID          int    `json:"id"`
@@ -53,10 +65,17 @@ type QuestCharacter struct {
 	UpdatedBy int       `json:"updated_by"`
 }
 
+// QuestCharacterRequest is used to create or update a quest character
+type QuestCharacterRequest struct {
+	CharacterID int     `json:"character_id"`
+	Description *string `json:"description,omitempty"`
+	IsPrivate   *bool   `json:"is_private,omitempty"`
+}
+
 // QuestItem is used to serialize a quest item object
 type QuestItem struct {
 	ID          int    `json:"id"`
-	ItemID      int    `json:"character_id"`
+	ItemID      int    `json:"item_id"`
 	Description string `json:"description"`
 	IsPrivate   bool   `json:"is_private"`
 
@@ -66,6 +85,13 @@ type QuestItem struct {
 	UpdatedBy int       `json:"updated_by"`
 }
 
+// QuestItemRequest is used to create or update a quest item
+type QuestItemRequest struct {
+	ItemID      int     `json:"item_id"`
+	Description *string `json:"description,omitempty"`
+	IsPrivate   *bool   `json:"is_private,omitempty"`
+}
+
 // QuestLocation is used to serialize a quest location object
 type QuestLocation struct {
 	ID          int    `json:"id"`
@@ -79,10 +105,17 @@ type QuestLocation struct {
 	UpdatedBy int       `json:"updated_by"`
 }
 
+// QuestLocationRequest is used to create or update a quest location
+type QuestLocationRequest struct {
+	LocationID  int     `json:"location_id"`
+	Description *string `json:"description,omitempty"`
+	IsPrivate   *bool   `json:"is_private,omitempty"`
+}
+
 // QuestOrganisation is used to serialize a quest organization object
 type QuestOrganisation struct {
 	ID             int    `json:"id"`
-	OrganisationID int    `json:"location_id"`
+	OrganisationID int    `json:"organisation_id"`
 	Description    string `json:"description"`
 	IsPrivate      bool   `json:"is_private"`
 
@@ -92,42 +125,355 @@ type QuestOrganisation struct {
 	UpdatedBy int       `json:"updated_by"`
 }
 
+// QuestOrganisationRequest is used to create or update a quest organisation
+type QuestOrganisationRequest struct {
+	OrganisationID int     `json:"organisation_id"`
+	Description    *string `json:"description,omitempty"`
+	IsPrivate      *bool   `json:"is_private,omitempty"`
+}
+
+// QuestParticipant is an element of a quest (e.g. a QuestCharacter) joined with the entity it refers to.
+// Entity is nil if the entity couldn't be fetched.
+type QuestParticipant[E any, T any] struct {
+	Element E
+	Entity  *T
+}
+
+// QuestBundle is a quest along with all of its participants, resolved to full entities
+type QuestBundle struct {
+	Quest         *Quest
+	Characters    []QuestParticipant[QuestCharacter, Character]
+	Items         []QuestParticipant[QuestItem, Item]
+	Locations     []QuestParticipant[QuestLocation, Location]
+	Organisations []QuestParticipant[QuestOrganisation, Organisation]
+}
+
+// CharacterQuest is a quest a character takes part in, as returned by Characters.GetQuests
+type CharacterQuest struct {
+	Quest Quest
+
+	// IsGiver is true if the character gives the quest, i.e. it is the quest's CharacterID
+	IsGiver bool
+
+	// Roles holds the quest characters which are the character, e.g. "Reluctant guide".
+	// It is empty if the character only gives the quest.
+	Roles []QuestCharacter
+}
+
+// QuestFilter is used to filter quests in ListOptions
+type QuestFilter struct {
+	CharacterID int
+	IsCompleted *bool
+	QuestID     int
+}
+
+// Apply implements Filter
+func (f QuestFilter) Apply(q url.Values) {
+	setInt(q, "character_id", f.CharacterID)
+	setBool(q, "is_completed", f.IsCompleted)
+	setInt(q, "quest_id", f.QuestID)
+}
+
 func init() {
 	addKnownLinkType("quest", func(ctx context.Context, client *Client, campaignID int, entityID int) string {
 		quest, _ := client.Quests(campaignID).GetQuest(ctx, entityID)
 		return quest.Name
 	})
+	addKnownSyncType("quest", func(ctx context.Context, client *Client, campaignID int, opts *ListOptions) ([]SyncRecord, error) {
+		records := []SyncRecord{}
+		err := client.Quests(campaignID).Iterate(ctx, opts, func(quest *Quest) error {
+			records = append(records, newSyncRecord(quest))
+			return nil
+		})
+		return records, err
+	})
+	addKnownEntityType("quest", func(ctx context.Context, client *Client, campaignID int, id int) (Entity, error) {
+		return client.Quests(campaignID).GetQuest(ctx, id)
+	})
 }
 
 // Quests returns a handle of the quests endpoint
 func (c *Client) Quests(campaignID int) *Quests {
 	return &Quests{
-		client:     c,
-		campaignID: campaignID,
-		urlPrefix:  fmt.Sprintf("/campaigns/%d/quests", campaignID),
+		EntityService: newEntityService[Quest, QuestRequest](c, campaignID, "quests"),
 	}
 }
 
 // GetQuests can return information about all quests
 func (q *Quests) GetQuests(ctx context.Context) (*[]Quest, error) {
+	return q.GetAll(ctx)
+}
+
+// GetQuest can return information about a single quest
+func (q *Quests) GetQuest(ctx context.Context, id int) (*Quest, error) {
+	return q.Get(ctx, id)
+}
+
+// GetQuestWithPosts can return information about a single quest along with all of its posts
+func (q *Quests) GetQuestWithPosts(ctx context.Context, id int) (*Quest, *[]EntityPost, error) {
+	return getWithPosts(ctx, q.EntityService, id)
+}
+
+// CreateQuest can create a new quest and return the result
+func (q *Quests) CreateQuest(ctx context.Context, quest *QuestRequest) (*Quest, error) {
+	return q.Create(ctx, quest)
+}
+
+// UpdateQuest can update a quest and return the result
+func (q *Quests) UpdateQuest(ctx context.Context, id int, quest *QuestRequest) (*Quest, error) {
+	return q.Update(ctx, id, quest)
+}
+
+// PatchQuest can update only the given fields of a quest and return the result
+func (q *Quests) PatchQuest(ctx context.Context, id int, fields map[string]interface{}) (*Quest, error) {
+	return q.Patch(ctx, id, fields)
+}
+
+// DeleteQuest can delete a quest
+func (q *Quests) DeleteQuest(ctx context.Context, id int) error {
+	return q.Delete(ctx, id)
+}
+
+// GetQuestCharacters can return information about all characters of a given quest
+func (q *Quests) GetQuestCharacters(ctx context.Context, id int) (*[]QuestCharacter, error) {
+	return questElements[QuestCharacter, QuestCharacterRequest](q, id, "quest_characters").GetAll(ctx)
+}
+
+// CreateQuestCharacter can add a character to a given quest and return the result
+func (q *Quests) CreateQuestCharacter(ctx context.Context, id int, character *QuestCharacterRequest) (*QuestCharacter, error) {
+	return questElements[QuestCharacter, QuestCharacterRequest](q, id, "quest_characters").Create(ctx, character)
+}
+
+// UpdateQuestCharacter can update a character of a given quest and return the result
+func (q *Quests) UpdateQuestCharacter(ctx context.Context, id int, questCharacterID int, character *QuestCharacterRequest) (*QuestCharacter, error) {
+	return questElements[QuestCharacter, QuestCharacterRequest](q, id, "quest_characters").Update(ctx, questCharacterID, character)
+}
+
+// PatchQuestCharacter can update only the given fields of a character of a given quest and return the result
+func (q *Quests) PatchQuestCharacter(ctx context.Context, id int, questCharacterID int, fields map[string]interface{}) (*QuestCharacter, error) {
+	return questElements[QuestCharacter, QuestCharacterRequest](q, id, "quest_characters").Patch(ctx, questCharacterID, fields)
+}
+
+// DeleteQuestCharacter can remove a character from a given quest
+func (q *Quests) DeleteQuestCharacter(ctx context.Context, id int, questCharacterID int) error {
+	return questElements[QuestCharacter, QuestCharacterRequest](q, id, "quest_characters").Delete(ctx, questCharacterID)
+}
+
+// GetQuestItems can return information about all items of a given quest
+func (q *Quests) GetQuestItems(ctx context.Context, id int) (*[]QuestItem, error) {
+	return questElements[QuestItem, QuestItemRequest](q, id, "quest_items").GetAll(ctx)
+}
+
+// CreateQuestItem can add an item to a given quest and return the result
+func (q *Quests) CreateQuestItem(ctx context.Context, id int, item *QuestItemRequest) (*QuestItem, error) {
+	return questElements[QuestItem, QuestItemRequest](q, id, "quest_items").Create(ctx, item)
+}
+
+// UpdateQuestItem can update an item of a given quest and return the result
+func (q *Quests) UpdateQuestItem(ctx context.Context, id int, questItemID int, item *QuestItemRequest) (*QuestItem, error) {
+	return questElements[QuestItem, QuestItemRequest](q, id, "quest_items").Update(ctx, questItemID, item)
+}
 
-	var err error
-	resp := []Quest{}
-	url := q.urlPrefix
+// PatchQuestItem can update only the given fields of an item of a given quest and return the result
+func (q *Quests) PatchQuestItem(ctx context.Context, id int, questItemID int, fields map[string]interface{}) (*QuestItem, error) {
+	return questElements[QuestItem, QuestItemRequest](q, id, "quest_items").Patch(ctx, questItemID, fields)
+}
+
+// DeleteQuestItem can remove an item from a given quest
+func (q *Quests) DeleteQuestItem(ctx context.Context, id int, questItemID int) error {
+	return questElements[QuestItem, QuestItemRequest](q, id, "quest_items").Delete(ctx, questItemID)
+}
+
+// GetQuestLocations can return information about all locations of a given quest
+func (q *Quests) GetQuestLocations(ctx context.Context, id int) (*[]QuestLocation, error) {
+	return questElements[QuestLocation, QuestLocationRequest](q, id, "quest_locations").GetAll(ctx)
+}
+
+// CreateQuestLocation can add a location to a given quest and return the result
+func (q *Quests) CreateQuestLocation(ctx context.Context, id int, location *QuestLocationRequest) (*QuestLocation, error) {
+	return questElements[QuestLocation, QuestLocationRequest](q, id, "quest_locations").Create(ctx, location)
+}
+
+// UpdateQuestLocation can update a location of a given quest and return the result
+func (q *Quests) UpdateQuestLocation(ctx context.Context, id int, questLocationID int, location *QuestLocationRequest) (*QuestLocation, error) {
+	return questElements[QuestLocation, QuestLocationRequest](q, id, "quest_locations").Update(ctx, questLocationID, location)
+}
+
+// PatchQuestLocation can update only the given fields of a location of a given quest and return the result
+func (q *Quests) PatchQuestLocation(ctx context.Context, id int, questLocationID int, fields map[string]interface{}) (*QuestLocation, error) {
+	return questElements[QuestLocation, QuestLocationRequest](q, id, "quest_locations").Patch(ctx, questLocationID, fields)
+}
+
+// DeleteQuestLocation can remove a location from a given quest
+func (q *Quests) DeleteQuestLocation(ctx context.Context, id int, questLocationID int) error {
+	return questElements[QuestLocation, QuestLocationRequest](q, id, "quest_locations").Delete(ctx, questLocationID)
+}
+
+// GetQuestOrganisations can return information about all organisations of a given quest
+func (q *Quests) GetQuestOrganisations(ctx context.Context, id int) (*[]QuestOrganisation, error) {
+	return questElements[QuestOrganisation, QuestOrganisationRequest](q, id, "quest_organisations").GetAll(ctx)
+}
+
+// CreateQuestOrganisation can add an organisation to a given quest and return the result
+func (q *Quests) CreateQuestOrganisation(ctx context.Context, id int, organisation *QuestOrganisationRequest) (*QuestOrganisation, error) {
+	return questElements[QuestOrganisation, QuestOrganisationRequest](q, id, "quest_organisations").Create(ctx, organisation)
+}
+
+// UpdateQuestOrganisation can update an organisation of a given quest and return the result
+func (q *Quests) UpdateQuestOrganisation(ctx context.Context, id int, questOrganisationID int, organisation *QuestOrganisationRequest) (*QuestOrganisation, error) {
+	return questElements[QuestOrganisation, QuestOrganisationRequest](q, id, "quest_organisations").Update(ctx, questOrganisationID, organisation)
+}
+
+// PatchQuestOrganisation can update only the given fields of an organisation of a given quest and return the result
+func (q *Quests) PatchQuestOrganisation(ctx context.Context, id int, questOrganisationID int, fields map[string]interface{}) (*QuestOrganisation, error) {
+	return questElements[QuestOrganisation, QuestOrganisationRequest](q, id, "quest_organisations").Patch(ctx, questOrganisationID, fields)
+}
 
-	for len(url) > 0 && err == nil {
-		page := []Quest{}
-		url, err = q.client.makeRequest(ctx, "GET", url, &page)
-		resp = append(resp, page...)
+// DeleteQuestOrganisation can remove an organisation from a given quest
+func (q *Quests) DeleteQuestOrganisation(ctx context.Context, id int, questOrganisationID int) error {
+	return questElements[QuestOrganisation, QuestOrganisationRequest](q, id, "quest_organisations").Delete(ctx, questOrganisationID)
+}
+
+// GetQuestBundle can return a quest along with all of its characters, items, locations and organisations.
+// The quest and its elements are fetched concurrently, then the entities the elements refer to, each once
+// and concurrently, sharing the client's rate-limiter. Elements whose entity can't be fetched have a nil
+// Entity, and the bundle is still returned along with the first error, checking characters, items, locations
+// and organisations in that order.
+func (q *Quests) GetQuestBundle(ctx context.Context, id int) (*QuestBundle, error) {
+
+	var quest *Quest
+	var characters *[]QuestCharacter
+	var items *[]QuestItem
+	var locations *[]QuestLocation
+	var organisations *[]QuestOrganisation
+
+	err := fetchAll(ctx,
+		func(ctx context.Context) (err error) {
+			quest, err = q.GetQuest(ctx, id)
+			return err
+		},
+		func(ctx context.Context) (err error) {
+			characters, err = q.GetQuestCharacters(ctx, id)
+			return err
+		},
+		func(ctx context.Context) (err error) {
+			items, err = q.GetQuestItems(ctx, id)
+			return err
+		},
+		func(ctx context.Context) (err error) {
+			locations, err = q.GetQuestLocations(ctx, id)
+			return err
+		},
+		func(ctx context.Context) (err error) {
+			organisations, err = q.GetQuestOrganisations(ctx, id)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, err
 	}
 
-	return &resp, err
+	bundle := &QuestBundle{Quest: quest}
+	err = fetchAll(ctx,
+		func(ctx context.Context) (err error) {
+			bundle.Characters, err = joinMany(ctx, q.client.Characters(q.campaignID).EntityService, "character", *characters,
+				func(c QuestCharacter) int { return c.CharacterID }, questParticipant[QuestCharacter, Character])
+			return err
+		},
+		func(ctx context.Context) (err error) {
+			bundle.Items, err = joinMany(ctx, q.client.Items(q.campaignID).EntityService, "item", *items,
+				func(i QuestItem) int { return i.ItemID }, questParticipant[QuestItem, Item])
+			return err
+		},
+		func(ctx context.Context) (err error) {
+			bundle.Locations, err = joinMany(ctx, q.client.Locations(q.campaignID).EntityService, "location", *locations,
+				func(l QuestLocation) int { return l.LocationID }, questParticipant[QuestLocation, Location])
+			return err
+		},
+		func(ctx context.Context) (err error) {
+			bundle.Organisations, err = joinMany(ctx, q.client.Organisations(q.campaignID).EntityService, "organisation", *organisations,
+				func(o QuestOrganisation) int { return o.OrganisationID }, questParticipant[QuestOrganisation, Organisation])
+			return err
+		},
+	)
+
+	return bundle, err
 }
 
-// GetQuest can return information about a single quest
-func (q *Quests) GetQuest(ctx context.Context, id int) (*Quest, error) {
+// questElements returns a service for one kind of element of a given quest
+func questElements[T any, R any](q *Quests, id int, endpoint string) *EntityService[T, R] {
+	return newEntityService[T, R](q.client, q.campaignID, fmt.Sprintf("quests/%d/%s", id, endpoint))
+}
+
+// questParticipant joins a quest element with the entity it refers to
+func questParticipant[E any, T any](element E, entity *T) QuestParticipant[E, T] {
+	return QuestParticipant[E, T]{Element: element, Entity: entity}
+}
+
+// GetID implements Entity
+func (q *Quest) GetID() int {
+	return q.ID
+}
+
+// GetEntityID implements Entity
+func (q *Quest) GetEntityID() int {
+	return q.EntityID
+}
+
+// GetName implements Entity
+func (q *Quest) GetName() string {
+	return q.Name
+}
+
+// EntityType implements Entity
+func (q *Quest) EntityType() string {
+	return "quest"
+}
+
+// GetEntry implements Entity
+func (q *Quest) GetEntry() string {
+	return q.Entry
+}
+
+// GetType implements Entity
+func (q *Quest) GetType() string {
+	return q.Type
+}
+
+// GetIsPrivate implements Entity
+func (q *Quest) GetIsPrivate() bool {
+	return q.IsPrivate
+}
+
+// GetImage implements Entity
+func (q *Quest) GetImage() string {
+	return q.Image
+}
+
+// GetImageThumb implements Entity
+func (q *Quest) GetImageThumb() string {
+	return q.ImageThumb
+}
 
-	resp := Quest{}
-	_, err := q.client.makeRequest(ctx, "GET", fmt.Sprintf("%s/%d", q.urlPrefix, id), &resp)
-	return &resp, err
+// GetCreatedAt implements Entity
+func (q *Quest) GetCreatedAt() time.Time {
+	return q.CreatedAt
 }
+
+// GetCreatedBy implements Entity
+func (q *Quest) GetCreatedBy() int {
+	return q.CreatedBy
+}
+
+// GetUpdatedAt implements Entity
+func (q *Quest) GetUpdatedAt() time.Time {
+	return q.UpdatedAt
+}
+
+// GetUpdatedBy implements Entity
+func (q *Quest) GetUpdatedBy() int {
+	return q.UpdatedBy
+}
+
+// QuestPager is used to iterate over pages of quests
+type QuestPager = Pager[Quest]
@@ -99,3 +99,155 @@ func TestGetQuest(t *testing.T) {
 		assert.Equal(t, 1, q.UpdatedBy)
 	}
 }
+
+func TestGetQuestElements(t *testing.T) {
+
+	testServer, config := mockTestServer()
+	defer testServer.Close()
+	client := NewClient(config)
+	ctx := context.Background()
+	quests := client.Quests(1)
+
+	characters, err := quests.GetQuestCharacters(ctx, 1)
+	if assert.NoError(t, err) && assert.Len(t, *characters, 2) {
+		c := (*characters)[1]
+		assert.Equal(t, 2, c.ID)
+		assert.Equal(t, 1, c.CharacterID)
+		assert.Equal(t, "Reluctant guide", c.Description)
+		assert.Equal(t, true, c.IsPrivate)
+	}
+
+	items, err := quests.GetQuestItems(ctx, 1)
+	if assert.NoError(t, err) && assert.Len(t, *items, 1) {
+		assert.Equal(t, 1, (*items)[0].ItemID)
+	}
+
+	locations, err := quests.GetQuestLocations(ctx, 1)
+	if assert.NoError(t, err) && assert.Len(t, *locations, 1) {
+		assert.Equal(t, 1, (*locations)[0].LocationID)
+	}
+
+	organisations, err := quests.GetQuestOrganisations(ctx, 1)
+	if assert.NoError(t, err) && assert.Len(t, *organisations, 1) {
+		assert.Equal(t, 1, (*organisations)[0].OrganisationID)
+		assert.Equal(t, "Hunting the party", (*organisations)[0].Description)
+	}
+}
+
+func TestWriteQuestElements(t *testing.T) {
+
+	testServer, config, requests := recordingTestServer()
+	defer testServer.Close()
+	client := NewClient(config)
+	ctx := context.Background()
+	quests := client.Quests(1)
+
+	_, err := quests.CreateQuestCharacter(ctx, 1, &QuestCharacterRequest{CharacterID: 1, Description: String("Quest giver")})
+	assert.NoError(t, err)
+	assert.Equal(t, recordedRequest{Method: "POST", Path: "/campaigns/1/quests/1/quest_characters", Body: `{"character_id":1,"description":"Quest giver"}`}, requests.last())
+
+	_, err = quests.UpdateQuestCharacter(ctx, 1, 2, &QuestCharacterRequest{CharacterID: 2, IsPrivate: Bool(false)})
+	assert.NoError(t, err)
+	assert.Equal(t, recordedRequest{Method: "PUT", Path: "/campaigns/1/quests/1/quest_characters/2", Body: `{"character_id":2,"is_private":false}`}, requests.last())
+
+	_, err = quests.PatchQuestCharacter(ctx, 1, 2, map[string]interface{}{"is_private": true})
+	assert.NoError(t, err)
+	assert.Equal(t, recordedRequest{Method: "PATCH", Path: "/campaigns/1/quests/1/quest_characters/2", Body: `{"is_private":true}`}, requests.last())
+
+	assert.NoError(t, quests.DeleteQuestCharacter(ctx, 1, 2))
+	assert.Equal(t, recordedRequest{Method: "DELETE", Path: "/campaigns/1/quests/1/quest_characters/2"}, requests.last())
+
+	_, err = quests.CreateQuestItem(ctx, 1, &QuestItemRequest{ItemID: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, recordedRequest{Method: "POST", Path: "/campaigns/1/quests/1/quest_items", Body: `{"item_id":1}`}, requests.last())
+
+	_, err = quests.UpdateQuestItem(ctx, 1, 2, &QuestItemRequest{ItemID: 2, Description: String("")})
+	assert.NoError(t, err)
+	assert.Equal(t, recordedRequest{Method: "PUT", Path: "/campaigns/1/quests/1/quest_items/2", Body: `{"item_id":2,"description":""}`}, requests.last())
+
+	_, err = quests.PatchQuestItem(ctx, 1, 2, map[string]interface{}{"is_private": true})
+	assert.NoError(t, err)
+	assert.Equal(t, recordedRequest{Method: "PATCH", Path: "/campaigns/1/quests/1/quest_items/2", Body: `{"is_private":true}`}, requests.last())
+
+	assert.NoError(t, quests.DeleteQuestItem(ctx, 1, 2))
+	assert.Equal(t, recordedRequest{Method: "DELETE", Path: "/campaigns/1/quests/1/quest_items/2"}, requests.last())
+
+	_, err = quests.CreateQuestLocation(ctx, 1, &QuestLocationRequest{LocationID: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, recordedRequest{Method: "POST", Path: "/campaigns/1/quests/1/quest_locations", Body: `{"location_id":1}`}, requests.last())
+
+	_, err = quests.UpdateQuestLocation(ctx, 1, 2, &QuestLocationRequest{LocationID: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, recordedRequest{Method: "PUT", Path: "/campaigns/1/quests/1/quest_locations/2", Body: `{"location_id":2}`}, requests.last())
+
+	_, err = quests.PatchQuestLocation(ctx, 1, 2, map[string]interface{}{"is_private": true})
+	assert.NoError(t, err)
+	assert.Equal(t, recordedRequest{Method: "PATCH", Path: "/campaigns/1/quests/1/quest_locations/2", Body: `{"is_private":true}`}, requests.last())
+
+	assert.NoError(t, quests.DeleteQuestLocation(ctx, 1, 2))
+	assert.Equal(t, recordedRequest{Method: "DELETE", Path: "/campaigns/1/quests/1/quest_locations/2"}, requests.last())
+
+	_, err = quests.CreateQuestOrganisation(ctx, 1, &QuestOrganisationRequest{OrganisationID: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, recordedRequest{Method: "POST", Path: "/campaigns/1/quests/1/quest_organisations", Body: `{"organisation_id":1}`}, requests.last())
+
+	_, err = quests.UpdateQuestOrganisation(ctx, 1, 2, &QuestOrganisationRequest{OrganisationID: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, recordedRequest{Method: "PUT", Path: "/campaigns/1/quests/1/quest_organisations/2", Body: `{"organisation_id":2}`}, requests.last())
+
+	_, err = quests.PatchQuestOrganisation(ctx, 1, 2, map[string]interface{}{"is_private": true})
+	assert.NoError(t, err)
+	assert.Equal(t, recordedRequest{Method: "PATCH", Path: "/campaigns/1/quests/1/quest_organisations/2", Body: `{"is_private":true}`}, requests.last())
+
+	assert.NoError(t, quests.DeleteQuestOrganisation(ctx, 1, 2))
+	assert.Equal(t, recordedRequest{Method: "DELETE", Path: "/campaigns/1/quests/1/quest_organisations/2"}, requests.last())
+}
+
+func TestGetQuestBundle(t *testing.T) {
+
+	testServer, config := mockTestServer()
+	defer testServer.Close()
+	client := NewClient(config)
+	ctx := context.Background()
+
+	bundle, err := client.Quests(1).GetQuestBundle(ctx, 1)
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, "Pelor's Quest", bundle.Quest.Name)
+
+		if assert.Len(t, bundle.Characters, 2) {
+			assert.Equal(t, "Quest giver", bundle.Characters[0].Element.Description)
+			if assert.NotNil(t, bundle.Characters[0].Entity) {
+				assert.Equal(t, "Jonathan Green", bundle.Characters[0].Entity.Name)
+			}
+			// Characters are shared between elements that refer to the same character
+			assert.Same(t, bundle.Characters[0].Entity, bundle.Characters[1].Entity)
+		}
+		if assert.Len(t, bundle.Items, 1) && assert.NotNil(t, bundle.Items[0].Entity) {
+			assert.Equal(t, "Spear", bundle.Items[0].Entity.Name)
+		}
+		if assert.Len(t, bundle.Locations, 1) && assert.NotNil(t, bundle.Locations[0].Entity) {
+			assert.Equal(t, "Mordor", bundle.Locations[0].Entity.Name)
+		}
+		if assert.Len(t, bundle.Organisations, 1) && assert.NotNil(t, bundle.Organisations[0].Entity) {
+			assert.Equal(t, "Tiamat Cultists", bundle.Organisations[0].Entity.Name)
+		}
+	}
+
+	// Missing participants are reported, but the rest of the bundle is still returned
+	config.Retry = nil
+	client = NewClient(config)
+
+	bundle, err = client.Quests(1).GetQuestBundle(ctx, 2)
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.EqualError(t, err, "fetching character 404: Non-2xx response: 404 Not Found")
+	if assert.NotNil(t, bundle) && assert.Len(t, bundle.Characters, 1) {
+		assert.Equal(t, "Lost Patrol", bundle.Quest.Name)
+		assert.Nil(t, bundle.Characters[0].Entity)
+		assert.Empty(t, bundle.Items)
+	}
+
+	// Quests which can't be fetched return no bundle
+	_, err = client.Quests(1).GetQuestBundle(ctx, 404)
+	assert.ErrorIs(t, err, ErrNotFound)
+}
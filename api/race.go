@@ -2,15 +2,13 @@ package kanka
 
 import (
 	"context"
-	"fmt"
+	"net/url"
 	"time"
 )
 
 // Races is used to query the races endpoints
 type Races struct {
-	client     *Client
-	campaignID int
-	urlPrefix  string
+	*EntityService[Race, RaceRequest]
 }
 
 // Race is used to serialize an race object
@@ -35,43 +33,150 @@ type Race struct {
 	UpdatedBy int       `json:"updated_by"`
 }
 
+// RaceRequest is used to create or update a race
+type RaceRequest struct {
+	Name   string  `json:"name"`
+	Entry  *string `json:"entry,omitempty"`
+	RaceID *int    `json:"race_id,omitempty"`
+	Type   *string `json:"type,omitempty"`
+
+	IsPrivate *bool `json:"is_private,omitempty"`
+}
+
+// RaceFilter is used to filter races in ListOptions
+type RaceFilter struct {
+	RaceID int
+}
+
+// Apply implements Filter
+func (f RaceFilter) Apply(q url.Values) {
+	setInt(q, "race_id", f.RaceID)
+}
+
 func init() {
 	addKnownLinkType("race", func(ctx context.Context, client *Client, campaignID int, entityID int) string {
 		race, _ := client.Races(campaignID).GetRace(ctx, entityID)
 		return race.Name
 	})
+	addKnownSyncType("race", func(ctx context.Context, client *Client, campaignID int, opts *ListOptions) ([]SyncRecord, error) {
+		records := []SyncRecord{}
+		err := client.Races(campaignID).Iterate(ctx, opts, func(race *Race) error {
+			records = append(records, newSyncRecord(race))
+			return nil
+		})
+		return records, err
+	})
+	addKnownEntityType("race", func(ctx context.Context, client *Client, campaignID int, id int) (Entity, error) {
+		return client.Races(campaignID).GetRace(ctx, id)
+	})
 }
 
 // Races returns a handle of the races endpoint
 func (c *Client) Races(campaignID int) *Races {
 	return &Races{
-		client:     c,
-		campaignID: campaignID,
-		urlPrefix:  fmt.Sprintf("/campaigns/%d/races", campaignID),
+		EntityService: newEntityService[Race, RaceRequest](c, campaignID, "races"),
 	}
 }
 
 // GetRaces can return information about all races
 func (r *Races) GetRaces(ctx context.Context) (*[]Race, error) {
+	return r.GetAll(ctx)
+}
 
-	var err error
-	resp := []Race{}
-	url := r.urlPrefix
+// GetRace can return information about a single race
+func (r *Races) GetRace(ctx context.Context, id int) (*Race, error) {
+	return r.Get(ctx, id)
+}
 
-	for len(url) > 0 && err == nil {
-		page := []Race{}
-		url, err = r.client.makeRequest(ctx, "GET", url, &page)
-		resp = append(resp, page...)
-	}
+// GetRaceWithPosts can return information about a single race along with all of its posts
+func (r *Races) GetRaceWithPosts(ctx context.Context, id int) (*Race, *[]EntityPost, error) {
+	return getWithPosts(ctx, r.EntityService, id)
+}
 
-	return &resp, err
+// CreateRace can create a new race and return the result
+func (r *Races) CreateRace(ctx context.Context, race *RaceRequest) (*Race, error) {
+	return r.Create(ctx, race)
 }
 
-// GetRace can return information about a single race
-func (r *Races) GetRace(ctx context.Context, id int) (*Race, error) {
+// UpdateRace can update a race and return the result
+func (r *Races) UpdateRace(ctx context.Context, id int, race *RaceRequest) (*Race, error) {
+	return r.Update(ctx, id, race)
+}
+
+// PatchRace can update only the given fields of a race and return the result
+func (r *Races) PatchRace(ctx context.Context, id int, fields map[string]interface{}) (*Race, error) {
+	return r.Patch(ctx, id, fields)
+}
+
+// DeleteRace can delete a race
+func (r *Races) DeleteRace(ctx context.Context, id int) error {
+	return r.Delete(ctx, id)
+}
+
+// GetID implements Entity
+func (r *Race) GetID() int {
+	return r.ID
+}
+
+// GetEntityID implements Entity
+func (r *Race) GetEntityID() int {
+	return r.EntityID
+}
+
+// GetName implements Entity
+func (r *Race) GetName() string {
+	return r.Name
+}
+
+// EntityType implements Entity
+func (r *Race) EntityType() string {
+	return "race"
+}
+
+// GetEntry implements Entity
+func (r *Race) GetEntry() string {
+	return r.Entry
+}
 
-	resp := Race{}
-	_, err := r.client.makeRequest(ctx, "GET", fmt.Sprintf("%s/%d", r.urlPrefix, id), &resp)
+// GetType implements Entity
+func (r *Race) GetType() string {
+	return r.Type
+}
+
+// GetIsPrivate implements Entity
+func (r *Race) GetIsPrivate() bool {
+	return r.IsPrivate
+}
 
-	return &resp, err
+// GetImage implements Entity
+func (r *Race) GetImage() string {
+	return r.Image
 }
+
+// GetImageThumb implements Entity
+func (r *Race) GetImageThumb() string {
+	return r.ImageThumb
+}
+
+// GetCreatedAt implements Entity
+func (r *Race) GetCreatedAt() time.Time {
+	return r.CreatedAt
+}
+
+// GetCreatedBy implements Entity
+func (r *Race) GetCreatedBy() int {
+	return r.CreatedBy
+}
+
+// GetUpdatedAt implements Entity
+func (r *Race) GetUpdatedAt() time.Time {
+	return r.UpdatedAt
+}
+
+// GetUpdatedBy implements Entity
+func (r *Race) GetUpdatedBy() int {
+	return r.UpdatedBy
+}
+
+// RacePager is used to iterate over pages of races
+type RacePager = Pager[Race]
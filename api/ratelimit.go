@@ -0,0 +1,274 @@
+package kanka
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// RateLimiter is a token bucket which allows at most limit requests per interval.
+// Each request takes a token from the bucket, and each token is returned to the bucket
+// one interval after it was taken. Waiting is done by the caller, so no goroutines are
+// started and a cancelled context stops waiting immediately.
+type RateLimiter struct {
+	mu       sync.Mutex
+	interval time.Duration
+	tokens   []time.Time // when each token is next available, in ascending order
+}
+
+// RateLimitStatus describes the client's current request quota
+type RateLimitStatus struct {
+
+	// Limit is the number of requests per minute the client currently allows itself
+	Limit int
+	// Available is the number of requests the client could make right now without waiting
+	Available int
+
+	// ServerLimit and ServerRemaining are the last values reported by Kanka through the X-RateLimit-Limit
+	// and X-RateLimit-Remaining headers, and UpdatedAt is when they were reported. All are zero if Kanka
+	// has not reported a quota yet.
+	ServerLimit     int
+	ServerRemaining int
+	UpdatedAt       time.Time
+}
+
+// Reservation holds a token taken from a RateLimiter.
+// The request it was reserved for may proceed once Delay has elapsed.
+type Reservation struct {
+	limiter  *RateLimiter
+	at       time.Time // when the token may be used
+	returnAt time.Time // when the token is returned to the bucket
+	previous time.Time // when the token was available before it was reserved
+}
+
+// NewRateLimiter returns a rate-limiter allowing limit requests per interval.
+// A limit below 1 is treated as 1.
+func NewRateLimiter(limit int, interval time.Duration) *RateLimiter {
+
+	if limit < 1 {
+		limit = 1
+	}
+
+	return &RateLimiter{
+		interval: interval,
+		tokens:   make([]time.Time, limit),
+	}
+}
+
+// Limit returns the number of requests allowed per interval
+func (l *RateLimiter) Limit() int {
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return len(l.tokens)
+}
+
+// Available returns the number of tokens which can be taken without waiting
+func (l *RateLimiter) Available() int {
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.available(time.Now())
+}
+
+// SetLimit changes the number of requests allowed per interval. New tokens are available immediately,
+// while removing tokens removes the soonest available ones first. A limit below 1 is treated as 1.
+func (l *RateLimiter) SetLimit(limit int) {
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if limit < 1 {
+		limit = 1
+	}
+
+	for len(l.tokens) < limit {
+		l.insert(time.Time{})
+	}
+
+	if len(l.tokens) > limit {
+		l.tokens = l.tokens[len(l.tokens)-limit:]
+	}
+}
+
+// SetRemaining takes tokens from the bucket until no more than remaining are available without waiting.
+// This is used to stay within a quota that is shared with other clients. Taken tokens are returned to
+// the bucket one interval from now.
+func (l *RateLimiter) SetRemaining(remaining int) {
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	for excess := l.available(now) - remaining; excess > 0; excess-- {
+		l.tokens = l.tokens[1:]
+		l.insert(now.Add(l.interval))
+	}
+}
+
+// Interval returns how long it takes for a used token to be returned to the bucket
+func (l *RateLimiter) Interval() time.Duration {
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.interval
+}
+
+// SetInterval changes how long it takes for tokens to be returned to the bucket.
+// Tokens which have already been taken are returned on their original schedule.
+func (l *RateLimiter) SetInterval(interval time.Duration) {
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.interval = interval
+}
+
+// Reserve takes the next available token from the bucket and returns a reservation for it.
+// The caller must wait for the reservation's Delay before making its request, or Cancel it.
+func (l *RateLimiter) Reserve() *Reservation {
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+
+	// The earliest available token is always first
+	r := &Reservation{
+		limiter:  l,
+		at:       l.tokens[0],
+		previous: l.tokens[0],
+	}
+	if r.at.Before(now) {
+		r.at = now
+	}
+	r.returnAt = r.at.Add(l.interval)
+
+	l.tokens = l.tokens[1:]
+	l.insert(r.returnAt)
+
+	return r
+}
+
+// Wait blocks until a token is available or the context is done.
+// If the context's deadline would pass before a token is available, Wait returns immediately.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+
+	// Don't take a token for a request that has already been cancelled
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r := l.Reserve()
+	delay := r.Delay()
+
+	if deadline, ok := ctx.Deadline(); ok && deadline.Before(r.at) {
+		r.Cancel()
+		return fmt.Errorf("rate-limiter wait of %s would exceed context deadline: %w", delay, context.DeadlineExceeded)
+	}
+
+	if err := sleepContext(ctx, delay); err != nil {
+		r.Cancel()
+		return err
+	}
+
+	return nil
+}
+
+// Delay returns how long to wait before the reserved token may be used
+func (r *Reservation) Delay() time.Duration {
+
+	if delay := time.Until(r.at); delay > 0 {
+		return delay
+	}
+
+	return 0
+}
+
+// Cancel returns the reserved token to the bucket if it has not yet been used.
+// Cancelling a reservation whose delay has already elapsed does nothing.
+func (r *Reservation) Cancel() {
+
+	l := r.limiter
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if !time.Now().Before(r.at) {
+		return
+	}
+
+	for i, token := range l.tokens {
+		if token.Equal(r.returnAt) {
+			l.tokens = append(l.tokens[:i], l.tokens[i+1:]...)
+			l.insert(r.previous)
+			return
+		}
+	}
+}
+
+// available counts the tokens available at the given time; callers must hold the lock
+func (l *RateLimiter) available(now time.Time) int {
+	return sort.Search(len(l.tokens), func(i int) bool { return l.tokens[i].After(now) })
+}
+
+// insert adds a token to the bucket, keeping tokens in ascending order; callers must hold the lock
+func (l *RateLimiter) insert(token time.Time) {
+
+	i := sort.Search(len(l.tokens), func(i int) bool { return l.tokens[i].After(token) })
+	l.tokens = append(l.tokens, time.Time{})
+	copy(l.tokens[i+1:], l.tokens[i:])
+	l.tokens[i] = token
+}
+
+// RateLimitStatus returns the client's current request quota
+func (c *Client) RateLimitStatus() RateLimitStatus {
+
+	c.rateLimitMu.Lock()
+	status := c.rateLimitStatus
+	c.rateLimitMu.Unlock()
+
+	status.Limit = c.rateLimiter.Limit()
+	status.Available = c.rateLimiter.Available()
+
+	return status
+}
+
+// observeRateLimit records the quota reported by Kanka's X-RateLimit headers, and adjusts the
+// rate-limiter to match it if the client is configured to do so
+func (c *Client) observeRateLimit(header http.Header) {
+
+	limit, limitErr := strconv.Atoi(header.Get("X-RateLimit-Limit"))
+	remaining, remainingErr := strconv.Atoi(header.Get("X-RateLimit-Remaining"))
+	if limitErr != nil && remainingErr != nil {
+		return
+	}
+
+	c.rateLimitMu.Lock()
+	if limitErr == nil {
+		c.rateLimitStatus.ServerLimit = limit
+	}
+	if remainingErr == nil {
+		c.rateLimitStatus.ServerRemaining = remaining
+	}
+	c.rateLimitStatus.UpdatedAt = time.Now()
+	c.rateLimitMu.Unlock()
+
+	if !c.adaptiveRateLimit {
+		return
+	}
+
+	if limitErr == nil && limit > 0 {
+		c.rateLimiter.SetLimit(limit)
+	}
+
+	if remainingErr == nil && remaining >= 0 {
+		c.rateLimiter.SetRemaining(remaining)
+	}
+}
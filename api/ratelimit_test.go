@@ -0,0 +1,179 @@
+package kanka
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRateLimiter(t *testing.T) {
+
+	l := NewRateLimiter(30, time.Minute)
+	assert.Equal(t, 30, l.Limit())
+	assert.Equal(t, time.Minute, l.Interval())
+
+	// Limits must allow at least one request
+	l = NewRateLimiter(0, time.Minute)
+	assert.Equal(t, 1, l.Limit())
+
+	l.SetInterval(time.Second)
+	assert.Equal(t, time.Second, l.Interval())
+}
+
+func TestRateLimiterReserve(t *testing.T) {
+
+	l := NewRateLimiter(2, time.Minute)
+
+	// The first two reservations can proceed immediately
+	assert.Equal(t, time.Duration(0), l.Reserve().Delay())
+	assert.Equal(t, time.Duration(0), l.Reserve().Delay())
+
+	// The third has to wait for the first token to come back
+	r := l.Reserve()
+	assert.InDelta(t, float64(time.Minute), float64(r.Delay()), float64(time.Second))
+
+	// Cancelling returns the token, so the next reservation waits just as long
+	r.Cancel()
+	r = l.Reserve()
+	assert.InDelta(t, float64(time.Minute), float64(r.Delay()), float64(time.Second))
+
+	// Without cancelling, the next reservation has to wait for the second token
+	assert.InDelta(t, float64(time.Minute), float64(l.Reserve().Delay()), float64(time.Second))
+	assert.InDelta(t, float64(2*time.Minute), float64(l.Reserve().Delay()), float64(time.Second))
+}
+
+func TestRateLimiterWait(t *testing.T) {
+
+	l := NewRateLimiter(1, 100*time.Millisecond)
+	ctx := context.Background()
+
+	// The first wait returns immediately and the second waits for the interval
+	start := time.Now()
+	assert.NoError(t, l.Wait(ctx))
+	assert.NoError(t, l.Wait(ctx))
+	assert.True(t, time.Since(start) >= 100*time.Millisecond)
+
+	// Cancelled contexts don't take a token
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	assert.True(t, errors.Is(l.Wait(cancelled), context.Canceled))
+
+	// Waits that would exceed the context deadline return immediately
+	l.SetInterval(time.Minute)
+	assert.NoError(t, l.Wait(ctx))
+
+	deadline, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+
+	start = time.Now()
+	err := l.Wait(deadline)
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+	assert.True(t, time.Since(start) < 100*time.Millisecond)
+
+	// Cancelling a context while waiting stops the wait
+	waiting, cancel := context.WithCancel(ctx)
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		cancel()
+	}()
+
+	start = time.Now()
+	assert.True(t, errors.Is(l.Wait(waiting), context.Canceled))
+	assert.True(t, time.Since(start) < time.Second)
+}
+
+func TestRateLimiterGoroutines(t *testing.T) {
+
+	l := NewRateLimiter(1000, time.Minute)
+	ctx := context.Background()
+
+	// Waiting on the rate-limiter must not leave anything running in the background
+	before := runtime.NumGoroutine()
+	for i := 0; i < 1000; i++ {
+		assert.NoError(t, l.Wait(ctx))
+	}
+	assert.LessOrEqual(t, runtime.NumGoroutine(), before)
+}
+
+func TestRateLimiterAdjustments(t *testing.T) {
+
+	l := NewRateLimiter(2, time.Minute)
+	assert.Equal(t, 2, l.Available())
+
+	// Raising the limit adds tokens which are available immediately
+	l.SetLimit(5)
+	assert.Equal(t, 5, l.Limit())
+	assert.Equal(t, 5, l.Available())
+
+	// Taking away remaining requests leaves the limit alone
+	l.SetRemaining(3)
+	assert.Equal(t, 5, l.Limit())
+	assert.Equal(t, 3, l.Available())
+
+	// Having more remaining than available changes nothing
+	l.SetRemaining(10)
+	assert.Equal(t, 3, l.Available())
+
+	// Lowering the limit removes available tokens first
+	l.SetLimit(2)
+	assert.Equal(t, 2, l.Limit())
+	assert.Equal(t, 0, l.Available())
+}
+
+func TestAdaptiveRateLimit(t *testing.T) {
+
+	testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		res.Header().Set("Content-Type", "application/json")
+		res.Header().Set("X-RateLimit-Limit", "90")
+		res.Header().Set("X-RateLimit-Remaining", "40")
+		res.WriteHeader(200)
+		_, err := res.Write([]byte("{}"))
+		assert.NoError(t, err)
+	}))
+	defer func() { testServer.Close() }()
+
+	// Create client
+	config := DefaultConfig()
+	config.BaseURL = testServer.URL
+	config.ForceTLS = false
+
+	client := NewClient(config)
+	ctx := context.Background()
+
+	// Before any requests, the status only reflects the configuration
+	status := client.RateLimitStatus()
+	assert.Equal(t, 30, status.Limit)
+	assert.Equal(t, 30, status.Available)
+	assert.Equal(t, 0, status.ServerLimit)
+	assert.True(t, status.UpdatedAt.IsZero())
+
+	// The client adopts the server's limit and remaining quota
+	_, err := client.makeRequest(ctx, "GET", "/", nil)
+	assert.NoError(t, err)
+
+	status = client.RateLimitStatus()
+	assert.Equal(t, 90, status.Limit)
+	assert.Equal(t, 40, status.Available)
+	assert.Equal(t, 90, status.ServerLimit)
+	assert.Equal(t, 40, status.ServerRemaining)
+	assert.False(t, status.UpdatedAt.IsZero())
+
+	// Non-adaptive clients only record the server's quota
+	config.AdaptiveRateLimit = false
+	client = NewClient(config)
+
+	_, err = client.makeRequest(ctx, "GET", "/", nil)
+	assert.NoError(t, err)
+
+	status = client.RateLimitStatus()
+	assert.Equal(t, 30, status.Limit)
+	assert.Equal(t, 29, status.Available)
+	assert.Equal(t, 90, status.ServerLimit)
+	assert.Equal(t, 40, status.ServerRemaining)
+}
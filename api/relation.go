@@ -0,0 +1,315 @@
+package kanka
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Relations is used to query the relations endpoints of an entity
+type Relations struct {
+	*EntityService[Relation, RelationRequest]
+}
+
+// Relation is used to serialize an entity relation object. OwnerID and TargetID are entity IDs.
+type Relation struct {
+	ID        int  `json:"id"`
+	OwnerID   int  `json:"owner_id"`
+	TargetID  int  `json:"target_id"`
+	IsPrivate bool `json:"is_private"`
+	IsStar    bool `json:"is_star"`
+
+	Relation string `json:"relation"`
+	Attitude int    `json:"attitude"`
+	Colour   string `json:"colour"`
+
+	// MirrorID is the ID of the relation going the other way, if the relation is mirrored
+	MirrorID int `json:"mirror_id"`
+
+	CreatedAt time.Time `json:"created_at"`
+	CreatedBy int       `json:"created_by"`
+	UpdatedAt time.Time `json:"updated_at"`
+	UpdatedBy int       `json:"updated_by"`
+}
+
+// RelationRequest is used to create or update a relation
+type RelationRequest struct {
+	OwnerID  int     `json:"owner_id"`
+	TargetID int     `json:"target_id"`
+	Relation string  `json:"relation"`
+	Attitude *int    `json:"attitude,omitempty"`
+	Colour   *string `json:"colour,omitempty"`
+
+	// TwoWay also creates the mirrored relation from the target; it is only used when creating relations
+	TwoWay *bool `json:"two_way,omitempty"`
+
+	IsPrivate *bool `json:"is_private,omitempty"`
+	IsStar    *bool `json:"is_star,omitempty"`
+}
+
+// RelationGraph is an in-memory directed graph of the relations between entities, keyed by entity ID
+type RelationGraph struct {
+	relations map[int]Relation
+	outgoing  map[int][]int
+	incoming  map[int][]int
+}
+
+// Relations returns a handle on the relations endpoints of an entity
+func (c *Client) Relations(campaignID int, entityID int) *Relations {
+	return &Relations{
+		EntityService: newEntityService[Relation, RelationRequest](c, campaignID, fmt.Sprintf("entities/%d/relations", entityID)),
+	}
+}
+
+// GetRelations can return information about all relations of the entity
+func (r *Relations) GetRelations(ctx context.Context) (*[]Relation, error) {
+	return r.GetAll(ctx)
+}
+
+// GetRelation can return information about a single relation
+func (r *Relations) GetRelation(ctx context.Context, id int) (*Relation, error) {
+	return r.Get(ctx, id)
+}
+
+// CreateRelation can create a new relation and return the result.
+// Set TwoWay to also create the mirrored relation from the target.
+func (r *Relations) CreateRelation(ctx context.Context, relation *RelationRequest) (*Relation, error) {
+	return r.Create(ctx, relation)
+}
+
+// UpdateRelation can update a relation and return the result
+func (r *Relations) UpdateRelation(ctx context.Context, id int, relation *RelationRequest) (*Relation, error) {
+	return r.Update(ctx, id, relation)
+}
+
+// PatchRelation can update only the given fields of a relation and return the result
+func (r *Relations) PatchRelation(ctx context.Context, id int, fields map[string]interface{}) (*Relation, error) {
+	return r.Patch(ctx, id, fields)
+}
+
+// DeleteRelation can delete a relation
+func (r *Relations) DeleteRelation(ctx context.Context, id int) error {
+	return r.Delete(ctx, id)
+}
+
+// BuildRelationGraph walks every entity of a campaign and fetches its relations into a graph.
+// This costs one request per page of entities plus one per entity, so consider building graphs
+// from known relations with NewRelationGraph where possible.
+func (c *Client) BuildRelationGraph(ctx context.Context, campaignID int, opts *BatchOptions) (*RelationGraph, error) {
+
+	ids := []int{}
+	pager := c.Entities(campaignID).List(nil)
+	for pager.Next(ctx) {
+		for _, entity := range pager.Page() {
+			ids = append(ids, entity.ID)
+		}
+	}
+	if err := pager.Err(); err != nil {
+		return nil, err
+	}
+
+	var mu sync.Mutex
+	relations := []Relation{}
+	errs := fetchMany(ctx, ids, opts, func(ctx context.Context, i int, id int) error {
+		entityRelations, err := c.Relations(campaignID, id).GetRelations(ctx)
+		if err == nil {
+			mu.Lock()
+			relations = append(relations, *entityRelations...)
+			mu.Unlock()
+		}
+		return err
+	})
+
+	if id, err := lowestError(errs); err != nil {
+		return nil, fmt.Errorf("fetching relations of entity %d: %w", id, err)
+	}
+
+	return NewRelationGraph(relations), nil
+}
+
+// NewRelationGraph returns a graph of the given relations
+func NewRelationGraph(relations []Relation) *RelationGraph {
+
+	g := &RelationGraph{
+		relations: make(map[int]Relation, len(relations)),
+		outgoing:  make(map[int][]int),
+		incoming:  make(map[int][]int),
+	}
+
+	for _, relation := range relations {
+		if _, ok := g.relations[relation.ID]; ok {
+			continue
+		}
+		g.relations[relation.ID] = relation
+		g.outgoing[relation.OwnerID] = append(g.outgoing[relation.OwnerID], relation.ID)
+		g.incoming[relation.TargetID] = append(g.incoming[relation.TargetID], relation.ID)
+	}
+
+	// Keep queries deterministic regardless of the order relations were fetched in
+	for _, ids := range g.outgoing {
+		sort.Ints(ids)
+	}
+	for _, ids := range g.incoming {
+		sort.Ints(ids)
+	}
+
+	return g
+}
+
+// Len returns the number of relations in the graph
+func (g *RelationGraph) Len() int {
+	return len(g.relations)
+}
+
+// Entities returns the entity IDs of every entity with relations in the graph, in ascending order
+func (g *RelationGraph) Entities() []int {
+
+	seen := make(map[int]bool)
+	for _, relation := range g.relations {
+		seen[relation.OwnerID] = true
+		seen[relation.TargetID] = true
+	}
+
+	ids := make([]int, 0, len(seen))
+	for id := range seen {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+
+	return ids
+}
+
+// Relation returns the relation with the given ID
+func (g *RelationGraph) Relation(id int) (Relation, bool) {
+	relation, ok := g.relations[id]
+	return relation, ok
+}
+
+// Outgoing returns the relations owned by an entity
+func (g *RelationGraph) Outgoing(entityID int) []Relation {
+	return g.lookup(g.outgoing[entityID])
+}
+
+// Incoming returns the relations targeting an entity
+func (g *RelationGraph) Incoming(entityID int) []Relation {
+	return g.lookup(g.incoming[entityID])
+}
+
+// Neighbours returns the entity IDs an entity has relations with, in either direction, in ascending order
+func (g *RelationGraph) Neighbours(entityID int) []int {
+
+	seen := make(map[int]bool)
+	for _, id := range g.outgoing[entityID] {
+		seen[g.relations[id].TargetID] = true
+	}
+	for _, id := range g.incoming[entityID] {
+		seen[g.relations[id].OwnerID] = true
+	}
+	delete(seen, entityID)
+
+	ids := make([]int, 0, len(seen))
+	for id := range seen {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+
+	return ids
+}
+
+// ShortestPath returns the fewest relations leading from one entity to another, following relations
+// from owner to target. Returns an empty path if from and to are the same entity, and false if there is no path.
+func (g *RelationGraph) ShortestPath(from int, to int) ([]Relation, bool) {
+
+	if from == to {
+		return []Relation{}, true
+	}
+
+	// Breadth-first search, remembering the relation each entity was reached through
+	via := map[int]int{from: 0}
+	queue := []int{from}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		for _, id := range g.outgoing[current] {
+			target := g.relations[id].TargetID
+			if _, seen := via[target]; seen {
+				continue
+			}
+			via[target] = id
+
+			if target == to {
+				return g.path(via, from, to), true
+			}
+			queue = append(queue, target)
+		}
+	}
+
+	return nil, false
+}
+
+// Mirror returns the relation going the other way from the given relation, if it is mirrored
+func (g *RelationGraph) Mirror(relation Relation) (Relation, bool) {
+
+	if relation.MirrorID != 0 {
+		if mirror, ok := g.relations[relation.MirrorID]; ok {
+			return mirror, true
+		}
+	}
+
+	// Older relations may only be mirrored on one side
+	for _, id := range g.incoming[relation.OwnerID] {
+		if mirror := g.relations[id]; mirror.OwnerID == relation.TargetID && mirror.MirrorID == relation.ID {
+			return mirror, true
+		}
+	}
+
+	return Relation{}, false
+}
+
+// Mirrored returns every pair of mirrored relations, with the lower relation ID first
+func (g *RelationGraph) Mirrored() [][2]Relation {
+
+	ids := make([]int, 0, len(g.relations))
+	for id := range g.relations {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+
+	pairs := [][2]Relation{}
+	for _, id := range ids {
+		relation := g.relations[id]
+		if mirror, ok := g.Mirror(relation); ok && relation.ID < mirror.ID {
+			pairs = append(pairs, [2]Relation{relation, mirror})
+		}
+	}
+
+	return pairs
+}
+
+// lookup returns the relations with the given IDs
+func (g *RelationGraph) lookup(ids []int) []Relation {
+
+	relations := make([]Relation, 0, len(ids))
+	for _, id := range ids {
+		relations = append(relations, g.relations[id])
+	}
+
+	return relations
+}
+
+// path walks back from to along the relations found by ShortestPath
+func (g *RelationGraph) path(via map[int]int, from int, to int) []Relation {
+
+	path := []Relation{}
+	for current := to; current != from; {
+		relation := g.relations[via[current]]
+		path = append([]Relation{relation}, path...)
+		current = relation.OwnerID
+	}
+
+	return path
+}
@@ -0,0 +1,159 @@
+package kanka
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRelations(t *testing.T) {
+
+	client := NewClient(DefaultConfig())
+	r := client.Relations(1, 4)
+
+	assert.Equal(t, client, r.client)
+	assert.Equal(t, "/campaigns/1/entities/4/relations", r.urlPrefix)
+}
+
+func TestGetRelations(t *testing.T) {
+
+	testServer, config := mockTestServer()
+	defer testServer.Close()
+	client := NewClient(config)
+	ctx := context.Background()
+
+	relations, err := client.Relations(1, 4).GetRelations(ctx)
+
+	if assert.NoError(t, err) {
+		assert.Len(t, *relations, 2)
+
+		r := (*relations)[0]
+		assert.Equal(t, 1, r.ID)
+		assert.Equal(t, 4, r.OwnerID)
+		assert.Equal(t, 5, r.TargetID)
+		assert.Equal(t, "Friend", r.Relation)
+		assert.Equal(t, 50, r.Attitude)
+		assert.Equal(t, "#ff0000", r.Colour)
+		assert.Equal(t, true, r.IsStar)
+		assert.Equal(t, 0, r.MirrorID)
+	}
+}
+
+func TestGetRelation(t *testing.T) {
+
+	testServer, config := mockTestServer()
+	defer testServer.Close()
+	client := NewClient(config)
+	ctx := context.Background()
+
+	relation, err := client.Relations(1, 4).GetRelation(ctx, 1)
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, 1, relation.ID)
+		assert.Equal(t, "Friend", relation.Relation)
+	}
+}
+
+func TestWriteRelations(t *testing.T) {
+
+	testServer, config, requests := recordingTestServer()
+	defer testServer.Close()
+	client := NewClient(config)
+	ctx := context.Background()
+	relations := client.Relations(1, 4)
+
+	_, err := relations.CreateRelation(ctx, &RelationRequest{OwnerID: 4, TargetID: 7, Relation: "Mentor", TwoWay: Bool(true)})
+	assert.NoError(t, err)
+	assert.Equal(t, recordedRequest{Method: "POST", Path: "/campaigns/1/entities/4/relations", Body: `{"owner_id":4,"target_id":7,"relation":"Mentor","two_way":true}`}, requests.last())
+
+	_, err = relations.UpdateRelation(ctx, 1, &RelationRequest{OwnerID: 4, TargetID: 5, Relation: "Enemy", Attitude: Int(0)})
+	assert.NoError(t, err)
+	assert.Equal(t, recordedRequest{Method: "PUT", Path: "/campaigns/1/entities/4/relations/1", Body: `{"owner_id":4,"target_id":5,"relation":"Enemy","attitude":0}`}, requests.last())
+
+	_, err = relations.PatchRelation(ctx, 1, map[string]interface{}{"attitude": -50})
+	assert.NoError(t, err)
+	assert.Equal(t, recordedRequest{Method: "PATCH", Path: "/campaigns/1/entities/4/relations/1", Body: `{"attitude":-50}`}, requests.last())
+
+	assert.NoError(t, relations.DeleteRelation(ctx, 1))
+	assert.Equal(t, recordedRequest{Method: "DELETE", Path: "/campaigns/1/entities/4/relations/1"}, requests.last())
+}
+
+func TestRelationGraph(t *testing.T) {
+
+	g := NewRelationGraph([]Relation{
+		{ID: 1, OwnerID: 10, TargetID: 20, MirrorID: 2},
+		{ID: 2, OwnerID: 20, TargetID: 10, MirrorID: 1},
+		{ID: 3, OwnerID: 20, TargetID: 30},
+		{ID: 4, OwnerID: 30, TargetID: 40},
+		{ID: 5, OwnerID: 10, TargetID: 40},
+		{ID: 6, OwnerID: 50, TargetID: 10},
+		{ID: 6, OwnerID: 50, TargetID: 10},
+	})
+
+	// Duplicates are only counted once
+	assert.Equal(t, 6, g.Len())
+	assert.Equal(t, []int{10, 20, 30, 40, 50}, g.Entities())
+
+	relation, ok := g.Relation(3)
+	assert.True(t, ok)
+	assert.Equal(t, 30, relation.TargetID)
+
+	assert.Equal(t, []int{1, 5}, relationIDs(g.Outgoing(10)))
+	assert.Equal(t, []int{2, 6}, relationIDs(g.Incoming(10)))
+	assert.Equal(t, []int{20, 40, 50}, g.Neighbours(10))
+
+	// Shortest paths follow relations from owner to target
+	path, ok := g.ShortestPath(20, 40)
+	assert.True(t, ok)
+	assert.Equal(t, []int{2, 5}, relationIDs(path))
+
+	path, ok = g.ShortestPath(10, 10)
+	assert.True(t, ok)
+	assert.Empty(t, path)
+
+	_, ok = g.ShortestPath(40, 10)
+	assert.False(t, ok)
+
+	// Mirrors
+	mirror, ok := g.Mirror(relation)
+	assert.False(t, ok)
+	assert.Equal(t, Relation{}, mirror)
+
+	relation, _ = g.Relation(1)
+	mirror, ok = g.Mirror(relation)
+	assert.True(t, ok)
+	assert.Equal(t, 2, mirror.ID)
+
+	pairs := g.Mirrored()
+	if assert.Len(t, pairs, 1) {
+		assert.Equal(t, 1, pairs[0][0].ID)
+		assert.Equal(t, 2, pairs[0][1].ID)
+	}
+}
+
+func TestBuildRelationGraph(t *testing.T) {
+
+	testServer, config := mockTestServer()
+	defer testServer.Close()
+	client := NewClient(config)
+	ctx := context.Background()
+
+	g, err := client.BuildRelationGraph(ctx, 1, nil)
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, 2, g.Len())
+		assert.Equal(t, []int{5, 6}, g.Neighbours(4))
+	}
+}
+
+// relationIDs returns the IDs of the given relations
+func relationIDs(relations []Relation) []int {
+
+	ids := []int{}
+	for _, relation := range relations {
+		ids = append(ids, relation.ID)
+	}
+
+	return ids
+}
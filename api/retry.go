@@ -0,0 +1,193 @@
+package kanka
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+const (
+	// DefaultMaxAttempts is the number of times a request is attempted before the client gives up
+	DefaultMaxAttempts = 3
+	// DefaultInitialBackoff is the delay before the first retry, doubling on each subsequent retry
+	DefaultInitialBackoff = time.Second
+	// DefaultMaxBackoff caps the delay between retries (server-requested delays are not capped)
+	DefaultMaxBackoff = time.Second * 30
+)
+
+// RetryPolicy configures how the client retries failed requests.
+// The zero value disables retries.
+type RetryPolicy struct {
+
+	// MaxAttempts is the total number of attempts made for a request, including the first.
+	// Values of 1 or less disable retries.
+	MaxAttempts int
+
+	// InitialBackoff is the delay before the first retry. The delay doubles on each subsequent
+	// retry up to MaxBackoff, and is randomly jittered so that concurrent clients spread out.
+	// A MaxBackoff of zero uses DefaultMaxBackoff.
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+
+	// RetryableStatuses lists the HTTP statuses which are retried
+	RetryableStatuses []int
+
+	// RetryableError reports whether a network error is retried. A nil function retries no network errors.
+	RetryableError func(err error) bool
+
+	// RetryNonIdempotent allows POST and PATCH requests to be retried. This may create duplicate
+	// objects if a request reached Kanka but the response was lost, so it is disabled by default.
+	RetryNonIdempotent bool
+}
+
+// DefaultRetryPolicy returns the retry policy used by DefaultConfig
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts:    DefaultMaxAttempts,
+		InitialBackoff: DefaultInitialBackoff,
+		MaxBackoff:     DefaultMaxBackoff,
+		RetryableStatuses: []int{
+			http.StatusTooManyRequests,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+		RetryableError: isTransientError,
+	}
+}
+
+// isTransientError reports whether a network error is likely to go away on its own: timeouts,
+// refused or reset connections, and connections closed part way through a response
+func isTransientError(err error) bool {
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+
+	return errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// shouldRetryStatus reports whether a request which failed with the given status should be attempted again
+func (p *RetryPolicy) shouldRetryStatus(method string, attempt int, statusCode int) bool {
+
+	if !p.canRetry(method, attempt) {
+		return false
+	}
+
+	for _, retryable := range p.RetryableStatuses {
+		if statusCode == retryable {
+			return true
+		}
+	}
+
+	return false
+}
+
+// shouldRetryError reports whether a request which failed with the given network error should be attempted again
+func (p *RetryPolicy) shouldRetryError(method string, attempt int, err error) bool {
+	return p.canRetry(method, attempt) && p.RetryableError != nil && p.RetryableError(err)
+}
+
+// canRetry checks the attempt count and method idempotency
+func (p *RetryPolicy) canRetry(method string, attempt int) bool {
+
+	if attempt >= p.MaxAttempts {
+		return false
+	}
+
+	switch method {
+	case "GET", "HEAD", "OPTIONS", "PUT", "DELETE":
+		return true
+	}
+
+	return p.RetryNonIdempotent
+}
+
+// delay returns how long to wait before retrying a failed response. Delays requested by the server
+// through Retry-After or X-RateLimit-Reset take precedence over the exponential backoff.
+func (p *RetryPolicy) delay(attempt int, header http.Header, apiErr *APIError) time.Duration {
+
+	if apiErr.RetryAfter > 0 {
+		return apiErr.RetryAfter
+	}
+
+	if header.Get("X-RateLimit-Remaining") == "0" {
+		if wait := parseRateLimitReset(header.Get("X-RateLimit-Reset"), time.Now()); wait > 0 {
+			return wait
+		}
+	}
+
+	return p.backoff(attempt)
+}
+
+// backoff returns the jittered exponential backoff after the given (1-indexed) attempt
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+
+	maxBackoff := p.MaxBackoff
+	if maxBackoff <= 0 {
+		maxBackoff = DefaultMaxBackoff
+	}
+
+	backoff := p.InitialBackoff
+	for i := 1; i < attempt && backoff < maxBackoff; i++ {
+		backoff *= 2
+	}
+
+	if backoff > maxBackoff {
+		backoff = maxBackoff
+	}
+
+	if backoff <= 0 {
+		return 0
+	}
+
+	// Wait somewhere between half and all of the backoff
+	half := backoff / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1)) // #nosec G404 -- jitter needs no cryptographic randomness
+}
+
+// parseRateLimitReset converts an X-RateLimit-Reset header into a duration from now.
+// Kanka sends a Unix timestamp, but a small value is treated as a number of seconds.
+func parseRateLimitReset(value string, now time.Time) time.Duration {
+
+	reset, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || reset <= 0 {
+		return 0
+	}
+
+	// Anything before 2001-09-09 can't be a timestamp
+	if reset < 1000000000 {
+		return time.Duration(reset) * time.Second
+	}
+
+	if wait := time.Unix(reset, 0).Sub(now); wait > 0 {
+		return wait
+	}
+
+	return 0
+}
+
+// sleepContext waits for the given duration, returning early with the context's error if it is cancelled
+func sleepContext(ctx context.Context, duration time.Duration) error {
+
+	if duration <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,186 @@
+package kanka
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDefaultRetryPolicy(t *testing.T) {
+
+	p := DefaultRetryPolicy()
+
+	assert.Equal(t, 3, p.MaxAttempts)
+	assert.Equal(t, time.Second, p.InitialBackoff)
+	assert.Equal(t, 30*time.Second, p.MaxBackoff)
+	assert.Contains(t, p.RetryableStatuses, 429)
+	assert.False(t, p.RetryNonIdempotent)
+
+	// Only idempotent methods are retried by default
+	assert.True(t, p.shouldRetryStatus("GET", 1, 429))
+	assert.True(t, p.shouldRetryStatus("PUT", 1, 503))
+	assert.True(t, p.shouldRetryStatus("DELETE", 2, 502))
+	assert.False(t, p.shouldRetryStatus("POST", 1, 429))
+	assert.False(t, p.shouldRetryStatus("PATCH", 1, 429))
+
+	// Unlisted statuses and exhausted attempts are not retried
+	assert.False(t, p.shouldRetryStatus("GET", 1, 404))
+	assert.False(t, p.shouldRetryStatus("GET", 3, 429))
+
+	// A nil retry policy disables retries
+	client := NewClient(&Config{})
+	assert.False(t, client.retryPolicy.shouldRetryStatus("GET", 1, 429))
+}
+
+func TestRetryBackoff(t *testing.T) {
+
+	p := &RetryPolicy{
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     time.Second,
+	}
+
+	// Backoff doubles with each attempt and is jittered to between half and all of it
+	for attempt, expected := range map[int]time.Duration{1: 100, 2: 200, 3: 400, 4: 800, 5: 1000, 50: 1000} {
+		backoff := p.backoff(attempt)
+		assert.True(t, backoff >= expected*time.Millisecond/2, "attempt %d backoff %s too short", attempt, backoff)
+		assert.True(t, backoff <= expected*time.Millisecond, "attempt %d backoff %s too long", attempt, backoff)
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+
+	p := &RetryPolicy{InitialBackoff: time.Millisecond}
+
+	// Retry-After takes precedence
+	header := http.Header{}
+	header.Set("X-RateLimit-Remaining", "0")
+	header.Set("X-RateLimit-Reset", "20")
+	assert.Equal(t, 5*time.Second, p.delay(1, header, &APIError{RetryAfter: 5 * time.Second}))
+
+	// Then X-RateLimit-Reset, but only when no requests remain
+	assert.Equal(t, 20*time.Second, p.delay(1, header, &APIError{}))
+	header.Set("X-RateLimit-Remaining", "3")
+	assert.True(t, p.delay(1, header, &APIError{}) <= time.Millisecond)
+
+	// Reset may also be a Unix timestamp
+	now := time.Now()
+	reset := strconv.FormatInt(now.Add(time.Minute).Unix(), 10)
+	assert.InDelta(t, float64(time.Minute), float64(parseRateLimitReset(reset, now)), float64(time.Second))
+	assert.Equal(t, time.Duration(0), parseRateLimitReset("nope", now))
+}
+
+func TestRetryRequests(t *testing.T) {
+
+	var requests int32
+	testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+
+		count := atomic.AddInt32(&requests, 1)
+
+		switch req.URL.Path {
+		case "/flaky":
+			// Fails twice before succeeding
+			if count < 3 {
+				res.Header().Set("Retry-After", "0")
+				res.WriteHeader(503)
+				return
+			}
+		case "/throttled":
+			res.WriteHeader(429)
+			return
+		}
+
+		res.Header().Set("Content-Type", "application/json")
+		res.WriteHeader(200)
+		_, err := res.Write([]byte("{}"))
+		assert.NoError(t, err)
+	}))
+	defer func() { testServer.Close() }()
+
+	// Create client
+	config := DefaultConfig()
+	config.BaseURL = testServer.URL
+	config.ForceTLS = false
+	config.Retry.InitialBackoff = 10 * time.Millisecond
+
+	client := NewClient(config)
+	ctx := context.Background()
+
+	// Flaky requests eventually succeed
+	_, err := client.makeRequest(ctx, "GET", "/flaky", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&requests))
+
+	// Throttled requests give up after MaxAttempts
+	atomic.StoreInt32(&requests, 0)
+	_, err = client.makeRequest(ctx, "GET", "/throttled", nil)
+	assert.True(t, errors.Is(err, ErrRateLimited))
+	assert.Equal(t, int32(3), atomic.LoadInt32(&requests))
+
+	// Non-idempotent requests are not retried
+	atomic.StoreInt32(&requests, 0)
+	_, err = client.makeRequest(ctx, "POST", "/throttled", nil)
+	assert.True(t, errors.Is(err, ErrRateLimited))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+
+	// Retries stop when the context is cancelled
+	config.Retry.InitialBackoff = time.Minute
+	client = NewClient(config)
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err = client.makeRequest(ctx, "GET", "/throttled", nil)
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+	assert.True(t, time.Since(start) < time.Second)
+}
+
+func TestRetryErrors(t *testing.T) {
+
+	// Only transient network errors are retried by default
+	p := DefaultRetryPolicy()
+	assert.True(t, p.shouldRetryError("GET", 1, &net.OpError{Op: "read", Err: syscall.ECONNRESET}))
+	assert.True(t, p.shouldRetryError("GET", 1, &net.OpError{Op: "dial", Err: syscall.ECONNREFUSED}))
+	assert.True(t, p.shouldRetryError("GET", 1, &net.DNSError{Err: "timeout", IsTimeout: true}))
+	assert.True(t, p.shouldRetryError("GET", 1, io.ErrUnexpectedEOF))
+	assert.False(t, p.shouldRetryError("GET", 1, &net.DNSError{Err: "no such host", IsNotFound: true}))
+	assert.False(t, p.shouldRetryError("GET", 1, errors.New("unsupported protocol scheme")))
+
+	var attempts int32
+	failure := errors.New("x509: certificate signed by unknown authority")
+
+	config := DefaultConfig()
+	config.BaseURL = "http://kanka.test"
+	config.ForceTLS = false
+	config.Retry.InitialBackoff = time.Millisecond
+	config.Use(func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) (*http.Response, error) {
+			atomic.AddInt32(&attempts, 1)
+			return nil, failure
+		})
+	})
+
+	client := NewClient(config)
+	ctx := context.Background()
+
+	// Non-transient errors are attempted exactly once
+	_, err := client.makeRequest(ctx, "GET", "/characters", nil)
+	assert.True(t, errors.Is(err, failure))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&attempts))
+
+	// Transient errors are attempted MaxAttempts times
+	atomic.StoreInt32(&attempts, 0)
+	failure = &net.OpError{Op: "read", Err: syscall.ECONNRESET}
+	_, err = client.makeRequest(ctx, "GET", "/characters", nil)
+	assert.True(t, errors.Is(err, syscall.ECONNRESET))
+	assert.Equal(t, int32(3), atomic.LoadInt32(&attempts))
+}
@@ -0,0 +1,159 @@
+package kanka
+
+import (
+	"context"
+	"fmt"
+)
+
+// EntityService provides the endpoints shared by every type of entity in a campaign, where T is the
+// type of entity, e.g. Character, and R is the type used to create and update it, e.g. CharacterRequest.
+// Typed handles such as Characters embed an EntityService, so adding a new type of entity only needs
+// its structs, a handle and a constructor.
+type EntityService[T any, R any] struct {
+	client     *Client
+	campaignID int
+	urlPrefix  string
+}
+
+// Pager is used to iterate over pages of objects of type T
+type Pager[T any] struct {
+	pager
+	page []T
+}
+
+// newEntityService returns a service for the entities at the given endpoint of a campaign, e.g. "characters"
+func newEntityService[T any, R any](client *Client, campaignID int, endpoint string) *EntityService[T, R] {
+	return &EntityService[T, R]{
+		client:     client,
+		campaignID: campaignID,
+		urlPrefix:  fmt.Sprintf("/campaigns/%d/%s", campaignID, endpoint),
+	}
+}
+
+// getAll fetches every page of the given endpoint
+func getAll[T any](ctx context.Context, client *Client, endpoint string) (*[]T, error) {
+
+	var err error
+	resp := []T{}
+	url := endpoint
+
+	for len(url) > 0 && err == nil {
+		page := []T{}
+		url, err = client.makeRequest(ctx, "GET", url, &page)
+		resp = append(resp, page...)
+	}
+
+	return &resp, err
+}
+
+// newTypedPager returns a pager over objects of type T starting at the given endpoint
+func newTypedPager[T any](client *Client, endpoint string, opts *ListOptions) *Pager[T] {
+	return &Pager[T]{pager: newPager(client, endpoint, opts)}
+}
+
+// GetAll can return information about all entities, fetching every page
+func (s *EntityService[T, R]) GetAll(ctx context.Context) (*[]T, error) {
+	return getAll[T](ctx, s.client, s.urlPrefix)
+}
+
+// getAllMatching fetches every entity matching opts, e.g. the characters of a family
+func (s *EntityService[T, R]) getAllMatching(ctx context.Context, opts *ListOptions) (*[]T, error) {
+
+	resp := []T{}
+	err := s.Iterate(ctx, opts, func(obj *T) error {
+		resp = append(resp, *obj)
+		return nil
+	})
+
+	return &resp, err
+}
+
+// List returns a pager which fetches entities one page at a time
+func (s *EntityService[T, R]) List(opts *ListOptions) *Pager[T] {
+	return newTypedPager[T](s.client, s.urlPrefix, opts)
+}
+
+// Iterate calls fn with every entity matching opts, fetching pages as they are needed.
+// Iteration stops at the first error, either from a request or returned by fn.
+func (s *EntityService[T, R]) Iterate(ctx context.Context, opts *ListOptions, fn func(obj *T) error) error {
+
+	pager := s.List(opts)
+	for pager.Next(ctx) {
+		for i := range pager.Page() {
+			if err := fn(&pager.Page()[i]); err != nil {
+				return err
+			}
+		}
+	}
+
+	return pager.Err()
+}
+
+// Get can return information about a single entity
+func (s *EntityService[T, R]) Get(ctx context.Context, id int) (*T, error) {
+
+	resp := new(T)
+	_, err := s.client.makeRequest(ctx, "GET", fmt.Sprintf("%s/%d", s.urlPrefix, id), resp)
+	return resp, err
+}
+
+// GetMany can return information about several entities at once, fetching them concurrently.
+// Entities are returned in the same order as ids, with nil in place of any which could not be fetched.
+// The errors of failed fetches are returned keyed by ID.
+func (s *EntityService[T, R]) GetMany(ctx context.Context, ids []int, opts *BatchOptions) ([]*T, map[int]error) {
+
+	resp := make([]*T, len(ids))
+	errs := fetchMany(ctx, ids, opts, func(ctx context.Context, i int, id int) error {
+		obj, err := s.Get(ctx, id)
+		if err == nil {
+			resp[i] = obj
+		}
+		return err
+	})
+
+	return resp, errs
+}
+
+// Create can create a new entity and return the result.
+// Optional fields of the request are pointers, and are only sent when set.
+func (s *EntityService[T, R]) Create(ctx context.Context, obj *R) (*T, error) {
+
+	resp := new(T)
+	_, err := s.client.makeRequestWithBody(ctx, "POST", s.urlPrefix, obj, resp)
+	return resp, err
+}
+
+// Update can update an entity with the fields of the request and return the result.
+// Optional fields of the request are pointers, and are only sent when set, so e.g. Bool(false) can be sent.
+func (s *EntityService[T, R]) Update(ctx context.Context, id int, obj *R) (*T, error) {
+
+	resp := new(T)
+	_, err := s.client.makeRequestWithBody(ctx, "PUT", fmt.Sprintf("%s/%d", s.urlPrefix, id), obj, resp)
+	return resp, err
+}
+
+// Patch can update only the given fields of an entity and return the result
+func (s *EntityService[T, R]) Patch(ctx context.Context, id int, fields map[string]interface{}) (*T, error) {
+
+	resp := new(T)
+	_, err := s.client.makeRequestWithBody(ctx, "PATCH", fmt.Sprintf("%s/%d", s.urlPrefix, id), fields, resp)
+	return resp, err
+}
+
+// Delete can delete an entity
+func (s *EntityService[T, R]) Delete(ctx context.Context, id int) error {
+
+	_, err := s.client.makeRequest(ctx, "DELETE", fmt.Sprintf("%s/%d", s.urlPrefix, id), nil)
+	return err
+}
+
+// Next fetches the next page, returning false when there are no more pages or an error occurred
+func (p *Pager[T]) Next(ctx context.Context) bool {
+	p.page = []T{}
+	return p.next(ctx, &p.page)
+}
+
+// Page returns the objects of the current page
+func (p *Pager[T]) Page() []T {
+	return p.page
+}
@@ -0,0 +1,166 @@
+package kanka
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewEntityService(t *testing.T) {
+
+	client := NewClient(DefaultConfig())
+	s := newEntityService[Character, CharacterRequest](client, 1, "characters")
+
+	assert.Equal(t, client, s.client)
+	assert.Equal(t, 1, s.campaignID)
+	assert.Equal(t, "/campaigns/1/characters", s.urlPrefix)
+
+	// Typed handles share their service's fields
+	assert.Equal(t, s, client.Characters(1).EntityService)
+}
+
+func TestEntityService(t *testing.T) {
+
+	testServer, config, requests := recordingTestServer()
+	defer testServer.Close()
+
+	client := NewClient(config)
+	ctx := context.Background()
+	s := newEntityService[Character, CharacterRequest](client, 1, "characters")
+
+	all, err := s.GetAll(ctx)
+	if assert.NoError(t, err) {
+		assert.NotEmpty(t, *all)
+	}
+	assert.Equal(t, recordedRequest{Method: "GET", Path: "/campaigns/1/characters"}, requests.last())
+
+	character, err := s.Get(ctx, 1)
+	if assert.NoError(t, err) {
+		assert.Equal(t, 1, character.ID)
+	}
+	assert.Equal(t, recordedRequest{Method: "GET", Path: "/campaigns/1/characters/1"}, requests.last())
+
+	_, err = s.Get(ctx, 404)
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestEntityServiceWrites(t *testing.T) {
+
+	testServer, config, requests := recordingTestServer()
+	defer testServer.Close()
+
+	client := NewClient(config)
+	ctx := context.Background()
+	s := newEntityService[Character, CharacterRequest](client, 1, "characters")
+
+	// Only the fields which are set are sent, and read-only fields are never sent
+	created, err := s.Create(ctx, &CharacterRequest{Name: "Created", Title: String("Sir")})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "Created", created.Name)
+	}
+	assert.Equal(t, recordedRequest{Method: "POST", Path: "/campaigns/1/characters", Body: `{"name":"Created","title":"Sir"}`}, requests.last())
+
+	// Zero values can be sent, e.g. to clear a field
+	_, err = s.Update(ctx, 1, &CharacterRequest{Name: "Updated", Title: String(""), LocationID: Int(0), IsDead: Bool(false), IsPrivate: Bool(false)})
+	assert.NoError(t, err)
+	assert.Equal(t, recordedRequest{
+		Method: "PUT",
+		Path:   "/campaigns/1/characters/1",
+		Body:   `{"name":"Updated","title":"","location_id":0,"is_dead":false,"is_private":false}`,
+	}, requests.last())
+
+	patched, err := s.Patch(ctx, 1, map[string]interface{}{"is_private": false})
+	if assert.NoError(t, err) {
+		assert.Equal(t, false, patched.IsPrivate)
+	}
+	assert.Equal(t, recordedRequest{Method: "PATCH", Path: "/campaigns/1/characters/1", Body: `{"is_private":false}`}, requests.last())
+
+	assert.NoError(t, s.Delete(ctx, 1))
+	assert.Equal(t, recordedRequest{Method: "DELETE", Path: "/campaigns/1/characters/1"}, requests.last())
+}
+
+func TestEntityServiceList(t *testing.T) {
+
+	testServer, config, requests := recordingTestServer()
+	defer testServer.Close()
+
+	client := NewClient(config)
+	ctx := context.Background()
+	s := newEntityService[Character, CharacterRequest](client, 1, "characters")
+
+	pager := s.List(nil)
+
+	// There is only one page of characters
+	if assert.True(t, pager.Next(ctx)) {
+		assert.Len(t, pager.Page(), 1)
+		assert.Equal(t, 1, pager.Page()[0].ID)
+	}
+	assert.False(t, pager.Next(ctx))
+	assert.NoError(t, pager.Err())
+	assert.Equal(t, []recordedRequest{{Method: "GET", Path: "/campaigns/1/characters"}}, requests.all())
+}
+
+func TestEntityServiceGetMany(t *testing.T) {
+
+	testServer, config, requests := recordingTestServer()
+	defer testServer.Close()
+	config.Retry = nil
+
+	client := NewClient(config)
+	ctx := context.Background()
+	s := newEntityService[Character, CharacterRequest](client, 1, "characters")
+
+	characters, errs := s.GetMany(ctx, []int{1, 404}, nil)
+
+	// Only the first character exists
+	if assert.Len(t, characters, 2) {
+		assert.Equal(t, 1, characters[0].ID)
+		assert.Nil(t, characters[1])
+	}
+	assert.Len(t, errs, 1)
+	assert.True(t, errors.Is(errs[404], ErrNotFound))
+
+	// Requests are made concurrently, so they can arrive in any order
+	assert.ElementsMatch(t, []recordedRequest{
+		{Method: "GET", Path: "/campaigns/1/characters/1"},
+		{Method: "GET", Path: "/campaigns/1/characters/404"},
+	}, requests.all())
+}
+
+func TestEntityServiceIterate(t *testing.T) {
+
+	testServer := paginatedTestServer(t, 0)
+	defer testServer.Close()
+
+	config := DefaultConfig()
+	config.BaseURL = testServer.URL
+	config.ForceTLS = false
+
+	client := NewClient(config)
+	ctx := context.Background()
+	s := newEntityService[Character, CharacterRequest](client, 1, "characters")
+
+	// Every page is visited
+	ids := []int{}
+	err := s.Iterate(ctx, nil, func(character *Character) error {
+		ids = append(ids, character.ID)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3}, ids)
+
+	// Errors returned by fn stop the iteration
+	stop := errors.New("stop")
+	ids = []int{}
+	err = s.Iterate(ctx, nil, func(character *Character) error {
+		ids = append(ids, character.ID)
+		if character.ID == 2 {
+			return stop
+		}
+		return nil
+	})
+	assert.Equal(t, stop, err)
+	assert.Equal(t, []int{1, 2}, ids)
+}
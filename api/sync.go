@@ -0,0 +1,331 @@
+package kanka
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+var knownSyncTypes map[string]syncFetcher = make(map[string]syncFetcher)
+
+// syncFetcher lists objects of one entity type as sync records
+type syncFetcher func(ctx context.Context, client *Client, campaignID int, opts *ListOptions) ([]SyncRecord, error)
+
+// Syncer is used to incrementally fetch the objects of a campaign which changed since the last sync
+type Syncer struct {
+	client     *Client
+	campaignID int
+	store      SyncStore
+
+	// EntityTypes limits syncing to the given entity types (e.g. "character", "location").
+	// Defaults to all known entity types.
+	EntityTypes []string
+
+	// DetectDeletions lists every object of each entity type instead of only the changed ones, so that
+	// objects which no longer exist can be reported. This costs as many requests as a full download.
+	DetectDeletions bool
+}
+
+// SyncRecord is a single object returned by a sync
+type SyncRecord struct {
+	ID        int
+	EntityID  int
+	UpdatedAt time.Time
+
+	// Object is a pointer to the synced object, e.g. *Character for the "character" entity type
+	Object interface{}
+}
+
+// SyncChanges holds the changes to one entity type since the last sync
+type SyncChanges struct {
+	Created []SyncRecord
+	Updated []SyncRecord
+
+	// Deleted holds the IDs of deleted objects. It is only populated if the Syncer detects deletions.
+	Deleted []int
+}
+
+// SyncResult holds the changes found by a sync, keyed by entity type
+type SyncResult struct {
+	Changes map[string]*SyncChanges
+}
+
+// SyncMark is the high-water mark of one entity type in a campaign
+type SyncMark struct {
+	// LastSync is the latest UpdatedAt seen for the entity type
+	LastSync time.Time `json:"last_sync"`
+	// KnownIDs holds the IDs of all objects seen for the entity type
+	KnownIDs []int `json:"known_ids"`
+}
+
+// SyncStore is used to keep sync marks between syncs
+type SyncStore interface {
+	// LoadMark returns the mark for an entity type in a campaign, or nil if it has never been synced
+	LoadMark(campaignID int, entityType string) (*SyncMark, error)
+	// SaveMark stores the mark for an entity type in a campaign
+	SaveMark(campaignID int, entityType string, mark *SyncMark) error
+}
+
+// MemorySyncStore is a SyncStore which keeps marks in memory for the lifetime of the process
+type MemorySyncStore struct {
+	mu    sync.Mutex
+	marks map[string]SyncMark
+}
+
+// FileSyncStore is a SyncStore which keeps marks in a JSON file
+type FileSyncStore struct {
+	mu   sync.Mutex
+	path string
+}
+
+// Syncer returns a syncer for the given campaign which keeps its marks in store
+func (c *Client) Syncer(campaignID int, store SyncStore) *Syncer {
+	return &Syncer{
+		client:     c,
+		campaignID: campaignID,
+		store:      store,
+	}
+}
+
+// Sync fetches the objects which changed since the last sync and advances the stored marks.
+// Entity types are synced one after another; if one fails, the changes found so far are returned
+// along with the error, and the failed entity type will be retried in full on the next sync.
+func (s *Syncer) Sync(ctx context.Context) (*SyncResult, error) {
+
+	result := &SyncResult{
+		Changes: make(map[string]*SyncChanges),
+	}
+
+	entityTypes := s.EntityTypes
+	if len(entityTypes) == 0 {
+		for entityType := range knownSyncTypes {
+			entityTypes = append(entityTypes, entityType)
+		}
+		sort.Strings(entityTypes)
+	}
+
+	for _, entityType := range entityTypes {
+		changes, err := s.syncType(ctx, entityType)
+		if err != nil {
+			return result, fmt.Errorf("syncing %s: %w", entityType, err)
+		}
+		result.Changes[entityType] = changes
+	}
+
+	return result, nil
+}
+
+// syncType syncs a single entity type
+func (s *Syncer) syncType(ctx context.Context, entityType string) (*SyncChanges, error) {
+
+	fetch, ok := knownSyncTypes[entityType]
+	if !ok {
+		return nil, fmt.Errorf("unknown entity type %q", entityType)
+	}
+
+	mark, err := s.store.LoadMark(s.campaignID, entityType)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only ask for deltas if there is something to compare them with
+	full := mark == nil || s.DetectDeletions
+	if mark == nil {
+		mark = &SyncMark{}
+	}
+
+	opts := &ListOptions{}
+	if !full {
+		opts.LastSync = mark.LastSync
+	}
+
+	records, err := fetch(ctx, s.client, s.campaignID, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	known := make(map[int]bool, len(mark.KnownIDs))
+	for _, id := range mark.KnownIDs {
+		known[id] = true
+	}
+
+	changes := &SyncChanges{}
+	next := &SyncMark{LastSync: mark.LastSync}
+	seen := make(map[int]bool, len(records))
+
+	for _, record := range records {
+		seen[record.ID] = true
+
+		if record.UpdatedAt.After(next.LastSync) {
+			next.LastSync = record.UpdatedAt
+		}
+
+		switch {
+		case !known[record.ID]:
+			changes.Created = append(changes.Created, record)
+		case record.UpdatedAt.After(mark.LastSync):
+			changes.Updated = append(changes.Updated, record)
+		}
+	}
+
+	// Known objects missing from a full listing have been deleted, but deltas only tell us what changed
+	for id := range known {
+		if seen[id] {
+			continue
+		}
+		if full {
+			changes.Deleted = append(changes.Deleted, id)
+		} else {
+			seen[id] = true
+		}
+	}
+	sort.Ints(changes.Deleted)
+
+	for id := range seen {
+		next.KnownIDs = append(next.KnownIDs, id)
+	}
+	sort.Ints(next.KnownIDs)
+
+	return changes, s.store.SaveMark(s.campaignID, entityType, next)
+}
+
+// newSyncRecord returns the sync record of an entity
+func newSyncRecord(entity Entity) SyncRecord {
+	return SyncRecord{
+		ID:        entity.GetID(),
+		EntityID:  entity.GetEntityID(),
+		UpdatedAt: entity.GetUpdatedAt(),
+		Object:    entity,
+	}
+}
+
+// addKnownSyncType is used to register entity types which can be synced and the function to list them.
+// It should be called by all entity source files (e.g. character.go) in their init function
+func addKnownSyncType(entityType string, fn syncFetcher) {
+	knownSyncTypes[entityType] = fn
+}
+
+// syncMarkKey returns the key of a sync mark in a store
+func syncMarkKey(campaignID int, entityType string) string {
+	return fmt.Sprintf("%d/%s", campaignID, entityType)
+}
+
+// NewMemorySyncStore returns an empty in-memory sync store
+func NewMemorySyncStore() *MemorySyncStore {
+	return &MemorySyncStore{
+		marks: make(map[string]SyncMark),
+	}
+}
+
+// LoadMark implements SyncStore
+func (m *MemorySyncStore) LoadMark(campaignID int, entityType string) (*SyncMark, error) {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	mark, ok := m.marks[syncMarkKey(campaignID, entityType)]
+	if !ok {
+		return nil, nil
+	}
+
+	mark.KnownIDs = append([]int{}, mark.KnownIDs...)
+	return &mark, nil
+}
+
+// SaveMark implements SyncStore
+func (m *MemorySyncStore) SaveMark(campaignID int, entityType string, mark *SyncMark) error {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.marks[syncMarkKey(campaignID, entityType)] = SyncMark{
+		LastSync: mark.LastSync,
+		KnownIDs: append([]int{}, mark.KnownIDs...),
+	}
+
+	return nil
+}
+
+// NewFileSyncStore returns a sync store which keeps marks in the JSON file at path.
+// The file is created on the first save if it does not exist.
+func NewFileSyncStore(path string) *FileSyncStore {
+	return &FileSyncStore{
+		path: path,
+	}
+}
+
+// LoadMark implements SyncStore
+func (f *FileSyncStore) LoadMark(campaignID int, entityType string) (*SyncMark, error) {
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	marks, err := f.read()
+	if err != nil {
+		return nil, err
+	}
+
+	mark, ok := marks[syncMarkKey(campaignID, entityType)]
+	if !ok {
+		return nil, nil
+	}
+
+	return &mark, nil
+}
+
+// SaveMark implements SyncStore
+func (f *FileSyncStore) SaveMark(campaignID int, entityType string, mark *SyncMark) error {
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	marks, err := f.read()
+	if err != nil {
+		return err
+	}
+	marks[syncMarkKey(campaignID, entityType)] = *mark
+
+	encoded, err := json.Marshal(marks)
+	if err != nil {
+		return err
+	}
+
+	// Write to a temporary file first so that a failed write doesn't lose every mark
+	tmp, err := os.CreateTemp(filepath.Dir(f.path), filepath.Base(f.path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err = tmp.Write(encoded); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), f.path)
+}
+
+// read loads all marks from the file; callers must hold the lock
+func (f *FileSyncStore) read() (map[string]SyncMark, error) {
+
+	marks := make(map[string]SyncMark)
+
+	encoded, err := os.ReadFile(filepath.Clean(f.path))
+	if errors.Is(err, os.ErrNotExist) {
+		return marks, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return marks, json.Unmarshal(encoded, &marks)
+}
@@ -0,0 +1,215 @@
+package kanka
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// syncTestServer serves a mutable set of characters, honoring the lastSync query parameter
+type syncTestServer struct {
+	*httptest.Server
+	mu         sync.Mutex
+	characters map[int]Character
+	lastSyncs  []string
+}
+
+func newSyncTestServer(t *testing.T) *syncTestServer {
+
+	s := &syncTestServer{
+		characters: make(map[int]Character),
+	}
+
+	s.Server = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		lastSync := req.URL.Query().Get("lastSync")
+		s.lastSyncs = append(s.lastSyncs, lastSync)
+
+		since := time.Time{}
+		if lastSync != "" {
+			var err error
+			since, err = time.Parse(lastSyncFormat, lastSync)
+			assert.NoError(t, err)
+		}
+
+		data := []Character{}
+		for _, character := range s.characters {
+			if character.UpdatedAt.After(since) {
+				data = append(data, character)
+			}
+		}
+		sort.Slice(data, func(i, j int) bool { return data[i].ID < data[j].ID })
+
+		res.Header().Set("Content-Type", "application/json")
+		res.WriteHeader(200)
+		assert.NoError(t, json.NewEncoder(res).Encode(Response{Data: data}))
+	}))
+
+	return s
+}
+
+// put adds or updates a character
+func (s *syncTestServer) put(id int, updatedAt time.Time) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.characters[id] = Character{ID: id, Name: "Character", UpdatedAt: updatedAt}
+}
+
+// delete removes a character
+func (s *syncTestServer) delete(id int) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.characters, id)
+}
+
+// recordIDs returns the IDs of the given records
+func recordIDs(records []SyncRecord) []int {
+
+	ids := []int{}
+	for _, record := range records {
+		ids = append(ids, record.ID)
+	}
+
+	return ids
+}
+
+func TestSync(t *testing.T) {
+
+	testServer := newSyncTestServer(t)
+	defer testServer.Close()
+
+	config := DefaultConfig()
+	config.BaseURL = testServer.URL
+	config.ForceTLS = false
+
+	client := NewClient(config)
+	ctx := context.Background()
+
+	store := NewMemorySyncStore()
+	syncer := client.Syncer(1, store)
+	syncer.EntityTypes = []string{"character"}
+
+	day1 := time.Date(2021, time.January, 1, 12, 0, 0, 0, time.UTC)
+	day2 := day1.Add(24 * time.Hour)
+
+	// The first sync fetches everything
+	testServer.put(1, day1)
+	testServer.put(2, day1)
+
+	result, err := syncer.Sync(ctx)
+	if assert.NoError(t, err) {
+		changes := result.Changes["character"]
+		assert.Equal(t, []int{1, 2}, recordIDs(changes.Created))
+		assert.Empty(t, changes.Updated)
+		assert.Empty(t, changes.Deleted)
+		assert.IsType(t, &Character{}, changes.Created[0].Object)
+	}
+
+	mark, err := store.LoadMark(1, "character")
+	if assert.NoError(t, err) {
+		assert.Equal(t, day1, mark.LastSync)
+		assert.Equal(t, []int{1, 2}, mark.KnownIDs)
+	}
+
+	// The next sync only fetches changes since the high-water mark
+	testServer.put(2, day2)
+	testServer.put(3, day2)
+
+	result, err = syncer.Sync(ctx)
+	if assert.NoError(t, err) {
+		changes := result.Changes["character"]
+		assert.Equal(t, []int{3}, recordIDs(changes.Created))
+		assert.Equal(t, []int{2}, recordIDs(changes.Updated))
+		assert.Empty(t, changes.Deleted)
+	}
+	assert.Equal(t, "2021-01-01T12:00:00.000000Z", testServer.lastSyncs[len(testServer.lastSyncs)-1])
+
+	// Nothing changed
+	result, err = syncer.Sync(ctx)
+	if assert.NoError(t, err) {
+		changes := result.Changes["character"]
+		assert.Empty(t, changes.Created)
+		assert.Empty(t, changes.Updated)
+	}
+
+	// Deletions are only found by full listings
+	testServer.delete(1)
+
+	result, err = syncer.Sync(ctx)
+	if assert.NoError(t, err) {
+		assert.Empty(t, result.Changes["character"].Deleted)
+	}
+
+	syncer.DetectDeletions = true
+	result, err = syncer.Sync(ctx)
+	if assert.NoError(t, err) {
+		changes := result.Changes["character"]
+		assert.Empty(t, changes.Created)
+		assert.Empty(t, changes.Updated)
+		assert.Equal(t, []int{1}, changes.Deleted)
+	}
+	assert.Equal(t, "", testServer.lastSyncs[len(testServer.lastSyncs)-1])
+
+	mark, err = store.LoadMark(1, "character")
+	if assert.NoError(t, err) {
+		assert.Equal(t, day2, mark.LastSync)
+		assert.Equal(t, []int{2, 3}, mark.KnownIDs)
+	}
+
+	// Unknown entity types fail
+	syncer.EntityTypes = []string{"dragon"}
+	_, err = syncer.Sync(ctx)
+	assert.EqualError(t, err, `syncing dragon: unknown entity type "dragon"`)
+}
+
+func TestSyncKnownTypes(t *testing.T) {
+
+	for _, entityType := range []string{
+		"ability", "calendar", "character", "event", "family", "item", "journal", "location",
+		"map", "note", "organisation", "quest", "race", "tag", "timeline",
+	} {
+		assert.Contains(t, knownSyncTypes, entityType)
+	}
+}
+
+func TestFileSyncStore(t *testing.T) {
+
+	path := filepath.Join(t.TempDir(), "marks.json")
+	store := NewFileSyncStore(path)
+
+	// Missing files have no marks
+	mark, err := store.LoadMark(1, "character")
+	assert.NoError(t, err)
+	assert.Nil(t, mark)
+
+	// Marks survive being reopened
+	synced := time.Date(2021, time.January, 1, 12, 0, 0, 0, time.UTC)
+	assert.NoError(t, store.SaveMark(1, "character", &SyncMark{LastSync: synced, KnownIDs: []int{1, 2}}))
+	assert.NoError(t, store.SaveMark(2, "character", &SyncMark{LastSync: synced, KnownIDs: []int{3}}))
+
+	store = NewFileSyncStore(path)
+	mark, err = store.LoadMark(1, "character")
+	if assert.NoError(t, err) {
+		assert.Equal(t, synced, mark.LastSync)
+		assert.Equal(t, []int{1, 2}, mark.KnownIDs)
+	}
+
+	mark, err = store.LoadMark(2, "location")
+	assert.NoError(t, err)
+	assert.Nil(t, mark)
+}
@@ -3,14 +3,13 @@ package kanka
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"time"
 )
 
 // Tags is used to query the tags endpoints
 type Tags struct {
-	client     *Client
-	campaignID int
-	urlPrefix  string
+	*EntityService[Tag, TagRequest]
 }
 
 // Tag is used to serialize an tag object
@@ -37,43 +36,185 @@ type Tag struct {
 	UpdatedBy int       `json:"updated_by"`
 }
 
+// TagRequest is used to create or update a tag
+type TagRequest struct {
+	Name   string  `json:"name"`
+	Entry  *string `json:"entry,omitempty"`
+	Colour *string `json:"colour,omitempty"`
+	TagID  *int    `json:"tag_id,omitempty"`
+	Type   *string `json:"type,omitempty"`
+
+	IsPrivate *bool `json:"is_private,omitempty"`
+}
+
+// TagFilter is used to filter tags in ListOptions
+type TagFilter struct {
+	TagID int
+}
+
+// Apply implements Filter
+func (f TagFilter) Apply(q url.Values) {
+	setInt(q, "tag_id", f.TagID)
+}
+
 func init() {
 	addKnownLinkType("tag", func(ctx context.Context, client *Client, campaignID int, entityID int) string {
 		tag, _ := client.Tags(campaignID).GetTag(ctx, entityID)
 		return tag.Name
 	})
+	addKnownSyncType("tag", func(ctx context.Context, client *Client, campaignID int, opts *ListOptions) ([]SyncRecord, error) {
+		records := []SyncRecord{}
+		err := client.Tags(campaignID).Iterate(ctx, opts, func(tag *Tag) error {
+			records = append(records, newSyncRecord(tag))
+			return nil
+		})
+		return records, err
+	})
+	addKnownEntityType("tag", func(ctx context.Context, client *Client, campaignID int, id int) (Entity, error) {
+		return client.Tags(campaignID).GetTag(ctx, id)
+	})
 }
 
 // Tags returns a handle of the tags endpoint
 func (c *Client) Tags(campaignID int) *Tags {
 	return &Tags{
-		client:     c,
-		campaignID: campaignID,
-		urlPrefix:  fmt.Sprintf("/campaigns/%d/tags", campaignID),
+		EntityService: newEntityService[Tag, TagRequest](c, campaignID, "tags"),
 	}
 }
 
 // GetTags can return information about all tags
 func (t *Tags) GetTags(ctx context.Context) (*[]Tag, error) {
+	return t.GetAll(ctx)
+}
+
+// GetTag can return information about a single tag
+func (t *Tags) GetTag(ctx context.Context, id int) (*Tag, error) {
+	return t.Get(ctx, id)
+}
 
-	var err error
-	resp := []Tag{}
-	url := t.urlPrefix
+// GetTagWithPosts can return information about a single tag along with all of its posts
+func (t *Tags) GetTagWithPosts(ctx context.Context, id int) (*Tag, *[]EntityPost, error) {
+	return getWithPosts(ctx, t.EntityService, id)
+}
+
+// CreateTag can create a new tag and return the result
+func (t *Tags) CreateTag(ctx context.Context, tag *TagRequest) (*Tag, error) {
+	return t.Create(ctx, tag)
+}
+
+// UpdateTag can update a tag and return the result
+func (t *Tags) UpdateTag(ctx context.Context, id int, tag *TagRequest) (*Tag, error) {
+	return t.Update(ctx, id, tag)
+}
+
+// PatchTag can update only the given fields of a tag and return the result
+func (t *Tags) PatchTag(ctx context.Context, id int, fields map[string]interface{}) (*Tag, error) {
+	return t.Patch(ctx, id, fields)
+}
+
+// DeleteTag can delete a tag
+func (t *Tags) DeleteTag(ctx context.Context, id int) error {
+	return t.Delete(ctx, id)
+}
+
+// GetTaggedEntities can return the entities tagged with a given tag, resolved to their types (e.g. *Character).
+// Entities are fetched concurrently and returned in the order of the tag's Entities. Entities which can't be
+// resolved are left out, and the error of the lowest failed entity ID is returned along with the rest.
+func (t *Tags) GetTaggedEntities(ctx context.Context, id int) ([]Entity, error) {
+
+	tag, err := t.GetTag(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	resolved := make([]Entity, len(tag.Entities))
+	entities := t.client.Entities(t.campaignID)
+	errs := fetchMany(ctx, tag.Entities, nil, func(ctx context.Context, i int, entityID int) error {
+		entity, err := entities.Resolve(ctx, entityID)
+		if err == nil {
+			resolved[i] = entity
+		}
+		return err
+	})
 
-	for len(url) > 0 && err == nil {
-		page := []Tag{}
-		url, err = t.client.makeRequest(ctx, "GET", url, &page)
-		resp = append(resp, page...)
+	tagged := make([]Entity, 0, len(resolved))
+	for _, entity := range resolved {
+		if entity != nil {
+			tagged = append(tagged, entity)
+		}
 	}
 
-	return &resp, err
+	if entityID, err := lowestError(errs); err != nil {
+		return tagged, fmt.Errorf("resolving entity %d: %w", entityID, err)
+	}
+
+	return tagged, nil
 }
 
-// GetTag can return information about a single tag
-func (t *Tags) GetTag(ctx context.Context, id int) (*Tag, error) {
+// GetID implements Entity
+func (t *Tag) GetID() int {
+	return t.ID
+}
+
+// GetEntityID implements Entity
+func (t *Tag) GetEntityID() int {
+	return t.EntityID
+}
 
-	resp := Tag{}
-	_, err := t.client.makeRequest(ctx, "GET", fmt.Sprintf("%s/%d", t.urlPrefix, id), &resp)
+// GetName implements Entity
+func (t *Tag) GetName() string {
+	return t.Name
+}
+
+// EntityType implements Entity
+func (t *Tag) EntityType() string {
+	return "tag"
+}
+
+// GetEntry implements Entity
+func (t *Tag) GetEntry() string {
+	return t.Entry
+}
+
+// GetType implements Entity
+func (t *Tag) GetType() string {
+	return t.Type
+}
 
-	return &resp, err
+// GetIsPrivate implements Entity
+func (t *Tag) GetIsPrivate() bool {
+	return t.IsPrivate
 }
+
+// GetImage implements Entity
+func (t *Tag) GetImage() string {
+	return t.Image
+}
+
+// GetImageThumb implements Entity
+func (t *Tag) GetImageThumb() string {
+	return t.ImageThumb
+}
+
+// GetCreatedAt implements Entity
+func (t *Tag) GetCreatedAt() time.Time {
+	return t.CreatedAt
+}
+
+// GetCreatedBy implements Entity
+func (t *Tag) GetCreatedBy() int {
+	return t.CreatedBy
+}
+
+// GetUpdatedAt implements Entity
+func (t *Tag) GetUpdatedAt() time.Time {
+	return t.UpdatedAt
+}
+
+// GetUpdatedBy implements Entity
+func (t *Tag) GetUpdatedBy() int {
+	return t.UpdatedBy
+}
+
+// TagPager is used to iterate over pages of tags
+type TagPager = Pager[Tag]
@@ -92,3 +92,27 @@ func TestGetTag(t *testing.T) {
 		assert.Equal(t, 1, tag.UpdatedBy)
 	}
 }
+
+func TestGetTaggedEntities(t *testing.T) {
+
+	testServer, config := mockTestServer()
+	defer testServer.Close()
+	config.Retry = nil
+	client := NewClient(config)
+	ctx := context.Background()
+
+	// Missing entities are reported, but the rest are still returned
+	entities, err := client.Tags(1).GetTaggedEntities(ctx, 2)
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.EqualError(t, err, "resolving entity 404: Non-2xx response: 404 Not Found")
+
+	if assert.Len(t, entities, 1) {
+		character, ok := entities[0].(*Character)
+		if assert.True(t, ok) {
+			assert.Equal(t, "Jonathan Green", character.Name)
+		}
+	}
+
+	_, err = client.Tags(1).GetTaggedEntities(ctx, 404)
+	assert.ErrorIs(t, err, ErrNotFound)
+}
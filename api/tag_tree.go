@@ -0,0 +1,113 @@
+package kanka
+
+import (
+	"context"
+	"sort"
+)
+
+// TagTree is the hierarchy of a campaign's tags, built from each tag's parent TagID
+type TagTree struct {
+	tree tree[int, TagNode, *TagNode]
+}
+
+// TagNode is a single tag in a TagTree
+type TagNode struct {
+	Tag    Tag
+	branch treeLinks[TagNode]
+}
+
+// BuildTagTree fetches every tag of a campaign into a tree
+func (c *Client) BuildTagTree(ctx context.Context, campaignID int) (*TagTree, error) {
+
+	tags, err := c.Tags(campaignID).GetTags(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTagTree(*tags), nil
+}
+
+// NewTagTree returns the tree of the given tags.
+// Tags whose parent is missing, or whose parents form a loop, become roots.
+func NewTagTree(tags []Tag) *TagTree {
+
+	nodes := make([]*TagNode, 0, len(tags))
+	for _, tag := range tags {
+		nodes = append(nodes, &TagNode{Tag: tag})
+	}
+
+	return &TagTree{tree: newTree[int, TagNode](nodes)}
+}
+
+// Roots returns the tags without a parent, sorted by name
+func (t *TagTree) Roots() []*TagNode {
+	return t.tree.rootNodes()
+}
+
+// Node returns the node of the tag with the given ID
+func (t *TagTree) Node(tagID int) (*TagNode, bool) {
+	return t.tree.node(tagID)
+}
+
+// Walk calls fn for every node of the tree, depth-first, parents before their children
+func (t *TagTree) Walk(fn func(node *TagNode, depth int)) {
+	t.tree.walk(fn)
+}
+
+// Parent returns the node of the tag's parent, or nil if it is a root
+func (n *TagNode) Parent() *TagNode {
+	return n.branch.parent
+}
+
+// Children returns the nodes of the tag's children, sorted by name
+func (n *TagNode) Children() []*TagNode {
+	return n.branch.childNodes()
+}
+
+// Entities returns the entity IDs tagged with the tag of the given ID or any of its descendants, in ascending order
+func (t *TagTree) Entities(tagID int) []int {
+
+	node, ok := t.tree.nodes[tagID]
+	if !ok {
+		return []int{}
+	}
+
+	return node.AllEntities()
+}
+
+// AllEntities returns the entity IDs tagged with the node's tag or any of its descendants, in ascending order
+func (n *TagNode) AllEntities() []int {
+
+	seen := make(map[int]bool)
+	walkTree[int, TagNode](n, func(node *TagNode, depth int) {
+		for _, id := range node.Tag.Entities {
+			seen[id] = true
+		}
+	}, 0)
+
+	ids := make([]int, 0, len(seen))
+	for id := range seen {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+
+	return ids
+}
+
+// keys implements treeNode
+func (n *TagNode) keys() (int, int) {
+	return n.Tag.ID, n.Tag.TagID
+}
+
+// links implements treeNode
+func (n *TagNode) links() *treeLinks[TagNode] {
+	return &n.branch
+}
+
+// less sorts tags by name, then by ID
+func (n *TagNode) less(other *TagNode) bool {
+	if n.Tag.Name != other.Tag.Name {
+		return n.Tag.Name < other.Tag.Name
+	}
+	return n.Tag.ID < other.Tag.ID
+}
@@ -0,0 +1,64 @@
+package kanka
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTagTree(t *testing.T) {
+
+	tree := NewTagTree([]Tag{
+		{ID: 1, Name: "Religion", Entities: []int{10}},
+		{ID: 2, Name: "Gods", TagID: 1, Entities: []int{20, 21}},
+		{ID: 3, Name: "Evil gods", TagID: 2, Entities: []int{21, 30}},
+		{ID: 4, Name: "Cults", TagID: 1},
+	})
+
+	lines := []string{}
+	tree.Walk(func(node *TagNode, depth int) {
+		lines = append(lines, strings.Repeat("  ", depth)+node.Tag.Name)
+	})
+
+	assert.Equal(t, []string{
+		"Religion",
+		"  Cults",
+		"  Gods",
+		"    Evil gods",
+	}, lines)
+
+	node, ok := tree.Node(3)
+	if assert.True(t, ok) {
+		assert.Equal(t, "Gods", node.Parent().Tag.Name)
+	}
+
+	root, _ := tree.Node(1)
+	if assert.Nil(t, root.Parent()) && assert.Len(t, root.Children(), 2) {
+		assert.Equal(t, "Cults", root.Children()[0].Tag.Name)
+		assert.Equal(t, "Gods", root.Children()[1].Tag.Name)
+	}
+
+	// Entities are inherited from child tags
+	assert.Equal(t, []int{10, 20, 21, 30}, tree.Entities(1))
+	assert.Equal(t, []int{20, 21, 30}, tree.Entities(2))
+	assert.Equal(t, []int{}, tree.Entities(4))
+	assert.Equal(t, []int{}, tree.Entities(99))
+}
+
+func TestBuildTagTree(t *testing.T) {
+
+	testServer, config := mockTestServer()
+	defer testServer.Close()
+	client := NewClient(config)
+	ctx := context.Background()
+
+	tree, err := client.BuildTagTree(ctx, 1)
+
+	// The only tag's parent isn't in the campaign, so it becomes a root
+	if assert.NoError(t, err) && assert.Len(t, tree.Roots(), 1) {
+		assert.Equal(t, "Religion", tree.Roots()[0].Tag.Name)
+		assert.Equal(t, []int{352, 440}, tree.Roots()[0].AllEntities())
+	}
+}
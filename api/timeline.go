@@ -2,15 +2,12 @@ package kanka
 
 import (
 	"context"
-	"fmt"
 	"time"
 )
 
 // Timelines is used to query the timelines endpoints
 type Timelines struct {
-	client     *Client
-	campaignID int
-	urlPrefix  string
+	*EntityService[Timeline, TimelineRequest]
 }
 
 // Timeline is used to serialize an timeline object
@@ -36,6 +33,17 @@ type Timeline struct {
 	UpdatedBy int       `json:"updated_by"`
 }
 
+// TimelineRequest is used to create or update a timeline
+type TimelineRequest struct {
+	Name        string  `json:"name"`
+	Entry       *string `json:"entry,omitempty"`
+	Eras        []Era   `json:"eras,omitempty"`
+	RevertOrder *bool   `json:"revert_order,omitempty"`
+	Type        *string `json:"type,omitempty"`
+
+	IsPrivate *bool `json:"is_private,omitempty"`
+}
+
 // Era is used to serialize an era object
 type Era struct {
 	Name         string `json:"name"`
@@ -49,37 +57,125 @@ func init() {
 		timeline, _ := client.Timelines(campaignID).GetTimeline(ctx, entityID)
 		return timeline.Name
 	})
+	addKnownSyncType("timeline", func(ctx context.Context, client *Client, campaignID int, opts *ListOptions) ([]SyncRecord, error) {
+		records := []SyncRecord{}
+		err := client.Timelines(campaignID).Iterate(ctx, opts, func(timeline *Timeline) error {
+			records = append(records, newSyncRecord(timeline))
+			return nil
+		})
+		return records, err
+	})
+	addKnownEntityType("timeline", func(ctx context.Context, client *Client, campaignID int, id int) (Entity, error) {
+		return client.Timelines(campaignID).GetTimeline(ctx, id)
+	})
 }
 
 // Timelines returns a handle of the timelines endpoint
 func (c *Client) Timelines(campaignID int) *Timelines {
 	return &Timelines{
-		client:     c,
-		campaignID: campaignID,
-		urlPrefix:  fmt.Sprintf("/campaigns/%d/timelines", campaignID),
+		EntityService: newEntityService[Timeline, TimelineRequest](c, campaignID, "timelines"),
 	}
 }
 
 // GetTimelines can return information about all timelines
 func (t *Timelines) GetTimelines(ctx context.Context) (*[]Timeline, error) {
+	return t.GetAll(ctx)
+}
 
-	var err error
-	resp := []Timeline{}
-	url := t.urlPrefix
+// GetTimeline can return information about a single timeline
+func (t *Timelines) GetTimeline(ctx context.Context, id int) (*Timeline, error) {
+	return t.Get(ctx, id)
+}
 
-	for len(url) > 0 && err == nil {
-		page := []Timeline{}
-		url, err = t.client.makeRequest(ctx, "GET", url, &page)
-		resp = append(resp, page...)
-	}
+// GetTimelineWithPosts can return information about a single timeline along with all of its posts
+func (t *Timelines) GetTimelineWithPosts(ctx context.Context, id int) (*Timeline, *[]EntityPost, error) {
+	return getWithPosts(ctx, t.EntityService, id)
+}
 
-	return &resp, err
+// CreateTimeline can create a new timeline and return the result
+func (t *Timelines) CreateTimeline(ctx context.Context, timeline *TimelineRequest) (*Timeline, error) {
+	return t.Create(ctx, timeline)
 }
 
-// GetTimeline can return information about a single timeline
-func (t *Timelines) GetTimeline(ctx context.Context, id int) (*Timeline, error) {
+// UpdateTimeline can update a timeline and return the result
+func (t *Timelines) UpdateTimeline(ctx context.Context, id int, timeline *TimelineRequest) (*Timeline, error) {
+	return t.Update(ctx, id, timeline)
+}
+
+// PatchTimeline can update only the given fields of a timeline and return the result
+func (t *Timelines) PatchTimeline(ctx context.Context, id int, fields map[string]interface{}) (*Timeline, error) {
+	return t.Patch(ctx, id, fields)
+}
+
+// DeleteTimeline can delete a timeline
+func (t *Timelines) DeleteTimeline(ctx context.Context, id int) error {
+	return t.Delete(ctx, id)
+}
+
+// GetID implements Entity
+func (t *Timeline) GetID() int {
+	return t.ID
+}
+
+// GetEntityID implements Entity
+func (t *Timeline) GetEntityID() int {
+	return t.EntityID
+}
 
-	resp := Timeline{}
-	_, err := t.client.makeRequest(ctx, "GET", fmt.Sprintf("%s/%d", t.urlPrefix, id), &resp)
-	return &resp, err
+// GetName implements Entity
+func (t *Timeline) GetName() string {
+	return t.Name
 }
+
+// EntityType implements Entity
+func (t *Timeline) EntityType() string {
+	return "timeline"
+}
+
+// GetEntry implements Entity
+func (t *Timeline) GetEntry() string {
+	return t.Entry
+}
+
+// GetType implements Entity
+func (t *Timeline) GetType() string {
+	return t.Type
+}
+
+// GetIsPrivate implements Entity
+func (t *Timeline) GetIsPrivate() bool {
+	return t.IsPrivate
+}
+
+// GetImage implements Entity
+func (t *Timeline) GetImage() string {
+	return t.Image
+}
+
+// GetImageThumb implements Entity
+func (t *Timeline) GetImageThumb() string {
+	return t.ImageThumb
+}
+
+// GetCreatedAt implements Entity
+func (t *Timeline) GetCreatedAt() time.Time {
+	return t.CreatedAt
+}
+
+// GetCreatedBy implements Entity
+func (t *Timeline) GetCreatedBy() int {
+	return t.CreatedBy
+}
+
+// GetUpdatedAt implements Entity
+func (t *Timeline) GetUpdatedAt() time.Time {
+	return t.UpdatedAt
+}
+
+// GetUpdatedBy implements Entity
+func (t *Timeline) GetUpdatedBy() int {
+	return t.UpdatedBy
+}
+
+// TimelinePager is used to iterate over pages of timelines
+type TimelinePager = Pager[Timeline]
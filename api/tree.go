@@ -0,0 +1,159 @@
+package kanka
+
+import (
+	"sort"
+)
+
+// treeLinks holds the links of a tree node to its parent and children. Nodes hold it, and the tree sets it.
+type treeLinks[N any] struct {
+	parent   *N
+	children []*N
+}
+
+// childNodes returns a copy of the children, so that callers can't reorder the tree
+func (l *treeLinks[N]) childNodes() []*N {
+	return append([]*N{}, l.children...)
+}
+
+// treeNode is implemented by pointers to the nodes of a tree
+type treeNode[K comparable, N any] interface {
+	*N
+
+	// keys returns the key of the node and the key of its parent
+	keys() (key K, parentKey K)
+
+	// links returns the links of the node to its parent and children
+	links() *treeLinks[N]
+
+	// less reports whether the node is sorted before a sibling
+	less(other *N) bool
+}
+
+// tree is a hierarchy of nodes, built from the key of each node's parent.
+// The typed trees (e.g. TagTree) hold one, and wrap its methods with their own node types.
+type tree[K comparable, N any, PN treeNode[K, N]] struct {
+	roots []*N
+	nodes map[K]*N
+}
+
+// newTree links the given nodes into a tree. Nodes whose parent is missing, or whose parents form a loop,
+// become roots. Nodes with the same key as an earlier node are dropped.
+func newTree[K comparable, N any, PN treeNode[K, N]](nodes []*N) tree[K, N, PN] {
+
+	t := tree[K, N, PN]{
+		nodes: make(map[K]*N, len(nodes)),
+	}
+
+	for _, node := range nodes {
+		key, _ := PN(node).keys()
+		if _, ok := t.nodes[key]; !ok {
+			t.nodes[key] = node
+		}
+	}
+
+	for _, node := range t.nodes {
+		_, parentKey := PN(node).keys()
+		parent, ok := t.nodes[parentKey]
+		if !ok || t.loops(node) {
+			t.roots = append(t.roots, node)
+			continue
+		}
+		PN(node).links().parent = parent
+		PN(parent).links().children = append(PN(parent).links().children, node)
+	}
+
+	sortTreeNodes[K, N, PN](t.roots)
+	for _, node := range t.nodes {
+		sortTreeNodes[K, N, PN](PN(node).links().children)
+	}
+
+	return t
+}
+
+// rootNodes returns a copy of the nodes without a parent, in sorted order
+func (t *tree[K, N, PN]) rootNodes() []*N {
+	return append([]*N{}, t.roots...)
+}
+
+// node returns the node with the given key
+func (t *tree[K, N, PN]) node(id K) (*N, bool) {
+	node, ok := t.nodes[id]
+	return node, ok
+}
+
+// walk calls fn for every node of the tree, depth-first, parents before their children
+func (t *tree[K, N, PN]) walk(fn func(node *N, depth int)) {
+	for _, root := range t.roots {
+		walkTree[K, N, PN](root, fn, 0)
+	}
+}
+
+// ancestors returns the ancestors of the node with the given key, starting with its parent
+func (t *tree[K, N, PN]) ancestors(key K) []*N {
+
+	ancestors := []*N{}
+	node, ok := t.nodes[key]
+	if !ok {
+		return ancestors
+	}
+
+	for parent := PN(node).links().parent; parent != nil; parent = PN(parent).links().parent {
+		ancestors = append(ancestors, parent)
+	}
+
+	return ancestors
+}
+
+// descendants returns the descendants of the node with the given key, depth-first, parents before their children
+func (t *tree[K, N, PN]) descendants(key K) []*N {
+
+	descendants := []*N{}
+	node, ok := t.nodes[key]
+	if !ok {
+		return descendants
+	}
+
+	for _, child := range PN(node).links().children {
+		walkTree[K, N, PN](child, func(node *N, depth int) {
+			descendants = append(descendants, node)
+		}, 0)
+	}
+
+	return descendants
+}
+
+// loops returns true if following the parents of node leads back to it
+func (t *tree[K, N, PN]) loops(node *N) bool {
+
+	key, current := PN(node).keys()
+	seen := make(map[K]bool)
+	for {
+		if current == key {
+			return true
+		}
+
+		// Loops further up the tree which don't include node are broken up at their own nodes
+		parent, ok := t.nodes[current]
+		if !ok || seen[current] {
+			return false
+		}
+		seen[current] = true
+		_, current = PN(parent).keys()
+	}
+}
+
+// walkTree calls fn for the node and its descendants
+func walkTree[K comparable, N any, PN treeNode[K, N]](node *N, fn func(node *N, depth int), depth int) {
+
+	fn(node, depth)
+	for _, child := range PN(node).links().children {
+		walkTree[K, N, PN](child, fn, depth+1)
+	}
+}
+
+// sortTreeNodes sorts sibling nodes
+func sortTreeNodes[K comparable, N any, PN treeNode[K, N]](nodes []*N) {
+	sort.Slice(nodes, func(i, j int) bool {
+		return PN(nodes[i]).less(nodes[j])
+	})
+}
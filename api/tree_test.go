@@ -0,0 +1,92 @@
+package kanka
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testNode is a tree node with a name and a parent
+type testNode struct {
+	id       int
+	parentID int
+	name     string
+	branch   treeLinks[testNode]
+}
+
+// keys implements treeNode
+func (n *testNode) keys() (int, int) {
+	return n.id, n.parentID
+}
+
+// links implements treeNode
+func (n *testNode) links() *treeLinks[testNode] {
+	return &n.branch
+}
+
+// less sorts test nodes by name
+func (n *testNode) less(other *testNode) bool {
+	return n.name < other.name
+}
+
+// testNodeNames returns the names of the given nodes
+func testNodeNames(nodes []*testNode) []string {
+
+	names := []string{}
+	for _, node := range nodes {
+		names = append(names, node.name)
+	}
+
+	return names
+}
+
+func TestTree(t *testing.T) {
+
+	tree := newTree[int, testNode]([]*testNode{
+		{id: 1, name: "Root"},
+		{id: 2, name: "Child", parentID: 1},
+		{id: 3, name: "Grandchild", parentID: 2},
+		{id: 4, name: "Another child", parentID: 1},
+		{id: 4, name: "Duplicate", parentID: 1},
+		{id: 5, name: "Orphan", parentID: 99},
+		{id: 6, name: "Ouroboros", parentID: 7},
+		{id: 7, name: "Loop", parentID: 6},
+		{id: 8, name: "Tail", parentID: 7},
+		{id: 9, name: "Self", parentID: 9},
+	})
+
+	lines := []string{}
+	tree.walk(func(node *testNode, depth int) {
+		lines = append(lines, strings.Repeat("  ", depth)+node.name)
+	})
+
+	// Missing parents and loops become roots, and nodes are sorted
+	assert.Equal(t, []string{
+		"Loop",
+		"  Tail",
+		"Orphan",
+		"Ouroboros",
+		"Root",
+		"  Another child",
+		"  Child",
+		"    Grandchild",
+		"Self",
+	}, lines)
+	assert.Equal(t, []string{"Loop", "Orphan", "Ouroboros", "Root", "Self"}, testNodeNames(tree.rootNodes()))
+
+	node, ok := tree.node(3)
+	if assert.True(t, ok) {
+		assert.Equal(t, "Child", node.branch.parent.name)
+	}
+	_, ok = tree.node(99)
+	assert.False(t, ok)
+
+	assert.Equal(t, []string{"Child", "Root"}, testNodeNames(tree.ancestors(3)))
+	assert.Equal(t, []string{}, testNodeNames(tree.ancestors(1)))
+	assert.Equal(t, []string{}, testNodeNames(tree.ancestors(99)))
+
+	assert.Equal(t, []string{"Another child", "Child", "Grandchild"}, testNodeNames(tree.descendants(1)))
+	assert.Equal(t, []string{}, testNodeNames(tree.descendants(3)))
+	assert.Equal(t, []string{}, testNodeNames(tree.descendants(99)))
+}